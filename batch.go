@@ -0,0 +1,109 @@
+package readability
+
+import (
+	"context"
+	"io"
+	nurl "net/url"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// BatchInput is a page to parse with Parser.ParseBatch.
+type BatchInput struct {
+	Reader io.Reader
+	URL    *nurl.URL
+}
+
+// BatchResult is the result of parsing a BatchInput. Index is the position
+// of the input in the batch, since the results are sent as they complete.
+// Err is the error returned by Parser.ParseContext, in which case Article
+// may still hold the metadata, like it does for ErrNoContent.
+type BatchResult struct {
+	Index    int
+	URL      *nurl.URL
+	Article  Article
+	Err      error
+	Duration time.Duration
+}
+
+// ParseBatch parses the pages received from inputs on a pool of workers,
+// and sends their results to the returned channel as they complete. If
+// workers isn't positive, there is a worker for each CPU. Each page is
+// parsed like with ParseContext, so the same limits like MaxElemsToParse
+// apply to every page.
+//
+// The returned channel is closed once inputs is closed and all its pages
+// are parsed. If ctx is cancelled, the pages that are being parsed fail
+// with ctx.Err(), the pages left in inputs are skipped, and the channel
+// is closed without waiting for inputs to be closed.
+func (ps *Parser) ParseBatch(ctx context.Context, inputs <-chan BatchInput, workers int) <-chan BatchResult {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	type indexedInput struct {
+		BatchInput
+		index int
+	}
+
+	// Number the inputs in the order they are received, before they are
+	// distributed to the workers.
+	queue := make(chan indexedInput)
+	go func() {
+		defer close(queue)
+		for index := 0; ; index++ {
+			var input BatchInput
+			var ok bool
+			select {
+			case input, ok = <-inputs:
+				if !ok {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+
+			select {
+			case queue <- indexedInput{input, index}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	results := make(chan BatchResult)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for input := range queue {
+				start := time.Now()
+				article, err := ps.ParseContext(ctx, input.Reader, input.URL)
+				result := BatchResult{
+					Index:    input.index,
+					URL:      input.URL,
+					Article:  article,
+					Err:      err,
+					Duration: time.Since(start),
+				}
+
+				// Don't block on a consumer that stopped reading
+				// because ctx is cancelled.
+				select {
+				case results <- result:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
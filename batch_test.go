@@ -0,0 +1,86 @@
+package readability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	fp "path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_parseBatch(t *testing.T) {
+	names := []string{"wikipedia", "nytimes-3", "telegraph", "bbc-1", "mozilla-1"}
+	pages := make([][]byte, len(names))
+	for i, name := range names {
+		page, err := os.ReadFile(fp.Join("test-pages", name, "source.html"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		pages[i] = page
+	}
+
+	parser := NewParser()
+	parser.MaxElemsToParse = 2000
+
+	inputs := make(chan BatchInput)
+	go func() {
+		defer close(inputs)
+		for _, page := range pages {
+			inputs <- BatchInput{Reader: strings.NewReader(string(page)), URL: fakeHostURL}
+		}
+		inputs <- BatchInput{Reader: strings.NewReader("<html><body><p>Too short</p></body></html>"), URL: fakeHostURL}
+	}()
+
+	results := make(map[int]BatchResult)
+	for result := range parser.ParseBatch(context.Background(), inputs, 3) {
+		if _, exist := results[result.Index]; exist {
+			t.Errorf("input %d has more than one result", result.Index)
+		}
+		results[result.Index] = result
+	}
+
+	if len(results) != len(pages)+1 {
+		t.Fatalf("want %d results, got %d", len(pages)+1, len(results))
+	}
+
+	for i, page := range pages {
+		result := results[i]
+		expected, expectedErr := parser.Parse(strings.NewReader(string(page)), fakeHostURL)
+		if fmt.Sprint(result.Err) != fmt.Sprint(expectedErr) {
+			t.Errorf("%s: want error %v, got %v", names[i], expectedErr, result.Err)
+		}
+		if result.Article.Content != expected.Content {
+			t.Errorf("%s: content is different from Parse", names[i])
+		}
+		if result.Duration <= 0 {
+			t.Errorf("%s: duration should be measured", names[i])
+		}
+	}
+
+	// MaxElemsToParse applies to every page
+	if err := results[0].Err; !errors.Is(err, ErrTooManyElements) {
+		t.Errorf("wikipedia: want ErrTooManyElements, got %v", err)
+	}
+	if err := results[len(pages)].Err; !errors.Is(err, ErrNoContent) {
+		t.Errorf("short page: want ErrNoContent, got %v", err)
+	}
+}
+
+func Test_parseBatch_cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The inputs are never closed, so the batch only ends because ctx
+	// is cancelled.
+	inputs := make(chan BatchInput, 1)
+	inputs <- BatchInput{Reader: strings.NewReader("<html><body></body></html>"), URL: fakeHostURL}
+
+	parser := NewParser()
+	for result := range parser.ParseBatch(ctx, inputs, 2) {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("want context.Canceled, got %v", result.Err)
+		}
+	}
+}
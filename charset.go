@@ -0,0 +1,183 @@
+package readability
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gogs/chardet"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/charset"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// maxMetaCharsetScan is the max number of bytes that are scanned
+// for <meta charset> before giving up. Readability.js relies on the
+// browser for this, which only looks at the first 1024 bytes, but many
+// pages put their meta tags after long inline scripts and styles.
+const maxMetaCharsetScan = 64 * 1024
+
+// byteOrderMarks are the BOMs that are recognized, in the order they are
+// checked.
+var byteOrderMarks = []struct {
+	bom  []byte
+	name string
+}{
+	{[]byte{0xEF, 0xBB, 0xBF}, "utf-8"},
+	{[]byte{0xFE, 0xFF}, "utf-16be"},
+	{[]byte{0xFF, 0xFE}, "utf-16le"},
+}
+
+// decodeInput reads the whole input and transcodes it to UTF-8. It returns
+// the decoded content and the canonical name of the detected encoding.
+//
+// The encoding is determined from, in order:
+//   - the byte order mark,
+//   - the charset parameter of contentType (e.g. the HTTP header),
+//   - <meta charset> or <meta http-equiv="Content-Type"> in the document,
+//   - sniffing the content.
+//
+// A charset declared in <meta> is ignored if the content is valid UTF-8
+// that contains non-ASCII text, since it's common for saved pages to be
+// re-encoded to UTF-8 while keeping their original meta tags.
+func decodeInput(input io.Reader, contentType string) (io.Reader, string, error) {
+	content, err := io.ReadAll(input)
+	if err != nil {
+		return nil, "", err
+	}
+
+	enc, name := detectEncoding(content, contentType)
+
+	// The BOM is only used for detection, so strip it from the content
+	for _, bom := range byteOrderMarks {
+		if bom.name == name && bytes.HasPrefix(content, bom.bom) {
+			content = content[len(bom.bom):]
+			break
+		}
+	}
+
+	var r io.Reader = bytes.NewReader(content)
+	if name != "utf-8" {
+		r = transform.NewReader(r, enc.NewDecoder())
+	}
+
+	return normalizeTextEncoding(r), name, nil
+}
+
+// detectEncoding returns the encoding of content. See decodeInput for the
+// order in which the sources are checked.
+func detectEncoding(content []byte, contentType string) (encoding.Encoding, string) {
+	for _, bom := range byteOrderMarks {
+		if bytes.HasPrefix(content, bom.bom) {
+			enc, name := charset.Lookup(bom.name)
+			return enc, name
+		}
+	}
+
+	if _, params, err := mime.ParseMediaType(contentType); err == nil {
+		if enc, name := charset.Lookup(params["charset"]); enc != nil {
+			return enc, name
+		}
+	}
+
+	isUTF8 := utf8.Valid(content)
+	if isUTF8 && hasNonASCII(content) {
+		return charset.Lookup("utf-8")
+	}
+
+	if enc, name := charset.Lookup(metaCharset(content)); enc != nil {
+		// A document can't declare itself as UTF-16 in its own
+		// ASCII-compatible meta tag, so the spec treats it as UTF-8.
+		if strings.HasPrefix(name, "utf-16") {
+			return charset.Lookup("utf-8")
+		}
+		return enc, name
+	}
+
+	if isUTF8 {
+		return charset.Lookup("utf-8")
+	}
+
+	if result, err := chardet.NewHtmlDetector().DetectBest(content); err == nil {
+		if enc, name := charset.Lookup(result.Charset); enc != nil {
+			return enc, name
+		}
+	}
+
+	return charset.Lookup("windows-1252")
+}
+
+// metaCharset returns the charset declared by <meta charset> or by
+// <meta http-equiv="Content-Type">, or an empty string if there is none.
+func metaCharset(content []byte) string {
+	if len(content) > maxMetaCharsetScan {
+		content = content[:maxMetaCharsetScan]
+	}
+
+	z := html.NewTokenizer(bytes.NewReader(content))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return ""
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			tagName, hasAttr := z.TagName()
+			if string(tagName) == "body" {
+				return ""
+			}
+
+			if string(tagName) != "meta" || !hasAttr {
+				continue
+			}
+
+			var metaCharset, httpEquiv, metaContent string
+			for more := true; more; {
+				var key, val []byte
+				key, val, more = z.TagAttr()
+				switch string(key) {
+				case "charset":
+					metaCharset = string(val)
+				case "http-equiv":
+					httpEquiv = string(val)
+				case "content":
+					metaContent = string(val)
+				}
+			}
+
+			if metaCharset != "" {
+				return strings.TrimSpace(metaCharset)
+			}
+
+			if strings.EqualFold(strings.TrimSpace(httpEquiv), "content-type") {
+				if parts := RxCharset.FindStringSubmatch(metaContent); parts != nil {
+					return parts[1]
+				}
+			}
+		}
+	}
+}
+
+// hasNonASCII checks if content has any byte outside of ASCII.
+func hasNonASCII(content []byte) bool {
+	for _, c := range content {
+		if c >= utf8.RuneSelf {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeTextEncoding convert text encoding from NFD to NFC.
+// It also remove soft hyphen since apparently it's useless in web.
+// This is the same normalization that is done by dom.Parse.
+func normalizeTextEncoding(r io.Reader) io.Reader {
+	fnSoftHyphen := func(r rune) bool { return r == '\u00AD' }
+	softHyphenSet := runes.Predicate(fnSoftHyphen)
+	transformer := transform.Chain(norm.NFD, runes.Remove(softHyphenSet), norm.NFC)
+	return transform.NewReader(r, transformer)
+}
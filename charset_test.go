@@ -0,0 +1,115 @@
+package readability
+
+import (
+	"bytes"
+	"os"
+	fp "path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+func encodeTestPage(t *testing.T, enc encoding.Encoding, head, title string) []byte {
+	page := "<html><head>" + head + "<title>" + title + "</title></head>" +
+		"<body><p>" + title + "</p></body></html>"
+
+	encoded, err := enc.NewEncoder().String(page)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return []byte(encoded)
+}
+
+func Test_decodeInput(t *testing.T) {
+	scenarios := map[string]struct {
+		content      []byte
+		contentType  string
+		wantTitle    string
+		wantEncoding string
+	}{
+		"shift_jis in meta charset": {
+			content:      encodeTestPage(t, japanese.ShiftJIS, `<meta charset="Shift_JIS">`, "むかしむかし"),
+			wantTitle:    "むかしむかし",
+			wantEncoding: "shift_jis",
+		},
+		"gbk in http-equiv": {
+			content:      encodeTestPage(t, simplifiedchinese.GBK, `<meta http-equiv="Content-Type" content="text/html; charset=gb2312">`, "腾讯新闻"),
+			wantTitle:    "腾讯新闻",
+			wantEncoding: "gbk",
+		},
+		"windows-1251 in header": {
+			content:      encodeTestPage(t, charmap.Windows1251, "", "Новости дня"),
+			contentType:  "text/html; charset=windows-1251",
+			wantTitle:    "Новости дня",
+			wantEncoding: "windows-1251",
+		},
+		"header overrides meta": {
+			content:      encodeTestPage(t, charmap.ISO8859_1, `<meta charset="utf-8">`, "Smørrebrød"),
+			contentType:  "text/html; charset=iso-8859-1",
+			wantTitle:    "Smørrebrød",
+			wantEncoding: "windows-1252",
+		},
+		"utf-8 with bom": {
+			content:      append([]byte{0xEF, 0xBB, 0xBF}, encodeTestPage(t, encoding.Nop, "", "Blåbærgrød")...),
+			wantTitle:    "Blåbærgrød",
+			wantEncoding: "utf-8",
+		},
+		"sniffed": {
+			content:      encodeTestPage(t, japanese.ShiftJIS, "", "むかしむかし、あるところに、おじいさんとおばあさんが住んでいました。"),
+			wantTitle:    "むかしむかし、あるところに、おじいさんとおばあさんが住んでいました。",
+			wantEncoding: "shift_jis",
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t1 *testing.T) {
+			r, encoding, err := decodeInput(bytes.NewReader(scenario.content), scenario.contentType)
+			if err != nil {
+				t1.Fatal(err)
+			}
+
+			if encoding != scenario.wantEncoding {
+				t1.Errorf("encoding, want %q got %q\n", scenario.wantEncoding, encoding)
+			}
+
+			parser := NewParser()
+			article, _ := parser.Parse(r, fakeHostURL)
+			if strings.TrimSpace(article.Title) != strings.TrimSpace(scenario.wantTitle) {
+				t1.Errorf("title, want %q got %q\n", scenario.wantTitle, article.Title)
+			}
+		})
+	}
+}
+
+func Test_parseEncoding(t *testing.T) {
+	// The qq test page declares gb2312, but has been saved as UTF-8.
+	f, err := os.Open(fp.Join("test-pages", "qq", "source.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	metadata, err := decodeExpectedMetadata(fp.Join("test-pages", "qq", "expected-metadata.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parser := NewParser()
+	article, err := parser.Parse(f, fakeHostURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if article.Encoding != "utf-8" {
+		t.Errorf("encoding, want %q got %q\n", "utf-8", article.Encoding)
+	}
+
+	if article.Title != metadata.Title {
+		t.Errorf("title, want %q got %q\n", metadata.Title, article.Title)
+	}
+}
@@ -2,7 +2,9 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -28,6 +30,7 @@ const index = `<!DOCTYPE HTML>
    <legend>Get readability content</legend>
    <p><label for="url">URL </label><input type="url" name="url" style="width:90%"></p>
    <p><input type="checkbox" name="text" value="true">text only</p>
+   <p><input type="checkbox" name="format" value="markdown">markdown</p>
    <p><input type="checkbox" name="metadata" value="true">only get the page's metadata</p>
   </fieldset>
   <p><input type="submit"></p>
@@ -35,6 +38,12 @@ const index = `<!DOCTYPE HTML>
  </body>
 </html>`
 
+// fetcher is shared by all requests so they reuse the same connection pool.
+var fetcher readability.Fetcher = readability.NewHTTPFetcher(nil)
+
+// siteRules are the rules loaded from the file in --site-rules, if any.
+var siteRules *readability.SiteRules
+
 func main() {
 	rootCmd := &cobra.Command{
 		Use:   "go-readability [flags] [source]",
@@ -47,6 +56,9 @@ func main() {
 	rootCmd.Flags().StringP("http", "l", "", "start the http server at the specified address")
 	rootCmd.Flags().BoolP("metadata", "m", false, "only print the page's metadata")
 	rootCmd.Flags().BoolP("text", "t", false, "only print the page's text")
+	rootCmd.Flags().StringP("format", "f", "html", "format of the page's content: html, text or markdown")
+	rootCmd.Flags().String("debug-html", "", "write the page annotated with the extraction decisions to the specified file")
+	rootCmd.Flags().String("site-rules", "", "load the CSS selectors of known sites from the specified JSON or YAML file")
 
 	err := rootCmd.Execute()
 	if err != nil {
@@ -55,6 +67,20 @@ func main() {
 }
 
 func rootCmdHandler(cmd *cobra.Command, args []string) {
+	// Load the site rules, which are used by the HTTP server too
+	if siteRulesPath, _ := cmd.Flags().GetString("site-rules"); siteRulesPath != "" {
+		f, err := os.Open(siteRulesPath)
+		if err != nil {
+			log.Fatalln(err)
+		}
+
+		siteRules, err = readability.LoadSiteRules(f)
+		f.Close()
+		if err != nil {
+			log.Fatalln(err)
+		}
+	}
+
 	// Start HTTP server
 	httpListen, _ := cmd.Flags().GetString("http")
 	if httpListen != "" {
@@ -65,9 +91,14 @@ func rootCmdHandler(cmd *cobra.Command, args []string) {
 
 	// Get cmd parameter
 	metadataOnly, _ := cmd.Flags().GetBool("metadata")
-	textOnly, _ := cmd.Flags().GetBool("text")
+	format, _ := cmd.Flags().GetString("format")
+	if textOnly, _ := cmd.Flags().GetBool("text"); textOnly {
+		format = "text"
+	}
+	debugHTMLPath, _ := cmd.Flags().GetString("debug-html")
+
 	if len(args) > 0 {
-		content, err := getContent(args[0], metadataOnly, textOnly)
+		content, err := getContent(cmd.Context(), args[0], metadataOnly, format, debugHTMLPath)
 		if err != nil {
 			log.Fatalln(err)
 		}
@@ -80,7 +111,11 @@ func rootCmdHandler(cmd *cobra.Command, args []string) {
 
 func httpHandler(w http.ResponseWriter, r *http.Request) {
 	metadataOnly, _ := strconv.ParseBool(r.URL.Query().Get("metadata"))
-	textOnly, _ := strconv.ParseBool(r.URL.Query().Get("text"))
+	format := r.URL.Query().Get("format")
+	if textOnly, _ := strconv.ParseBool(r.URL.Query().Get("text")); textOnly {
+		format = "text"
+	}
+
 	url := r.URL.Query().Get("url")
 	if url == "" {
 		if _, err := w.Write([]byte(index)); err != nil {
@@ -90,7 +125,7 @@ func httpHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	} else {
 		log.Println("process URL", url)
-		content, err := getContent(url, metadataOnly, textOnly)
+		content, err := getContent(r.Context(), url, metadataOnly, format, "")
 		if err != nil {
 			log.Println(err)
 			http.Error(w, err.Error(), http.StatusBadRequest)
@@ -98,8 +133,10 @@ func httpHandler(w http.ResponseWriter, r *http.Request) {
 		}
 		if metadataOnly {
 			w.Header().Set("Content-Type", "application/json")
-		} else if textOnly {
+		} else if format == "text" {
 			w.Header().Set("Content-Type", "text/plain")
+		} else if format == "markdown" {
+			w.Header().Set("Content-Type", "text/markdown")
 		}
 		if _, err := w.Write([]byte(content)); err != nil {
 			log.Println(err)
@@ -109,48 +146,61 @@ func httpHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getContent(srcPath string, metadataOnly, textOnly bool) (string, error) {
-	// Open or fetch web page that will be parsed
-	var (
-		pageURL   *nurl.URL
-		srcReader io.Reader
-	)
+// getContent returns the readable content of the page at srcPath. If
+// debugHTMLPath isn't empty, the annotated page is written to that file.
+func getContent(ctx context.Context, srcPath string, metadataOnly bool, format, debugHTMLPath string) (string, error) {
+	switch format {
+	case "", "html", "text", "markdown":
+	default:
+		return "", fmt.Errorf("unknown format %q", format)
+	}
+
+	parser := readability.NewParser()
+	parser.DebugHTML = debugHTMLPath != ""
+	parser.SiteRules = siteRules
 
+	// Fetch or open the web page, and get its readable content. The page
+	// is fetched through ParseURL, so the charset in its Content-Type
+	// header is used to decode it.
+	var article readability.Article
+	var err error
 	if _, isURL := validateURL(srcPath); isURL {
-		resp, err := http.Get(srcPath)
-		if err != nil {
+		article, err = parser.ParseURL(ctx, srcPath, readability.FetcherFunc(fetchReadable))
+		if errors.Is(err, errNotReadable) {
+			return "", fmt.Errorf("failed to parse page: %v", errNotReadable)
+		} else if errors.Is(err, readability.ErrFetch) {
 			return "", fmt.Errorf("failed to fetch web page: %v", err)
 		}
-		defer resp.Body.Close()
-
-		pageURL = resp.Request.URL
-		srcReader = resp.Body
 	} else {
-		srcFile, err := os.Open(srcPath)
-		if err != nil {
-			return "", fmt.Errorf("failed to open source file: %v", err)
+		srcFile, openErr := os.Open(srcPath)
+		if openErr != nil {
+			return "", fmt.Errorf("failed to open source file: %v", openErr)
 		}
 		defer srcFile.Close()
 
-		pageURL, _ = nurl.ParseRequestURI("http://fakehost.com")
-		srcReader = srcFile
-	}
+		// Use tee so the reader can be used twice
+		buf := bytes.NewBuffer(nil)
+		tee := io.TeeReader(srcFile, buf)
 
-	// Use tee so the reader can be used twice
-	buf := bytes.NewBuffer(nil)
-	tee := io.TeeReader(srcReader, buf)
+		// Make sure the page is readable
+		if !readability.Check(tee) {
+			return "", fmt.Errorf("failed to parse page: %v", errNotReadable)
+		}
 
-	// Make sure the page is readable
-	if !readability.Check(tee) {
-		return "", fmt.Errorf("failed to parse page: the page is not readable")
+		pageURL, _ := nurl.ParseRequestURI("http://fakehost.com")
+		article, err = parser.Parse(buf, pageURL)
 	}
 
-	// Get readable content from the reader
-	article, err := readability.FromReader(buf, pageURL)
-	if err != nil {
+	if err != nil && !errors.Is(err, readability.ErrNoContent) {
 		return "", fmt.Errorf("failed to parse page: %v", err)
 	}
 
+	if debugHTMLPath != "" {
+		if err := os.WriteFile(debugHTMLPath, []byte(article.DebugHTML), 0o644); err != nil {
+			return "", fmt.Errorf("failed to write debug HTML: %v", err)
+		}
+	}
+
 	// Return the article (or its metadata)
 	if metadataOnly {
 		metadata := map[string]interface{}{
@@ -169,11 +219,40 @@ func getContent(srcPath string, metadataOnly, textOnly bool) (string, error) {
 		return string(prettyJSON), nil
 	}
 
-	if textOnly {
+	switch format {
+	case "text":
 		return article.TextContent, nil
+	case "markdown":
+		return article.Markdown(), nil
+	default:
+		return article.Content, nil
+	}
+}
+
+// errNotReadable is returned for the pages that don't pass readability.Check.
+var errNotReadable = errors.New("the page is not readable")
+
+// fetchReadable fetches pageURL using the shared fetcher, and fails with
+// errNotReadable if the fetched page isn't readable. The responses with a
+// non-2xx status are returned as is, so ParseURL can report them.
+func fetchReadable(ctx context.Context, pageURL string) (*http.Response, error) {
+	resp, err := fetcher.Fetch(ctx, pageURL)
+	if err != nil || resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp, err
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	if !readability.Check(bytes.NewReader(content)) {
+		return nil, errNotReadable
 	}
 
-	return article.Content, nil
+	resp.Body = io.NopCloser(bytes.NewReader(content))
+	return resp, nil
 }
 
 func validateURL(path string) (*nurl.URL, bool) {
@@ -0,0 +1,144 @@
+package readability
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/go-shiori/dom"
+	"golang.org/x/net/html"
+)
+
+// debugIDAttr identifies the nodes of the original page in the clones that
+// are processed while grabbing the article, so the decisions made on the
+// clones can be shown on the original page.
+const debugIDAttr = "data-readability-id"
+
+// The attributes and classes that annotate the debug HTML.
+const (
+	debugScoreAttr    = "data-readability-debug-score"
+	debugReasonAttr   = "data-readability-debug-reason"
+	debugCandidateCls = "readability-debug-candidate"
+	debugRemovedCls   = "readability-debug-removed"
+	debugSelectedCls  = "readability-debug-selected"
+	debugHTMLStyle    = `
+.readability-debug-candidate { outline: 2px dashed #e67e22 !important; position: relative; }
+.readability-debug-candidate::before {
+	content: attr(data-readability-debug-score); position: absolute; top: 0; left: 0; z-index: 2147483647;
+	background: #e67e22; color: #fff; font: 11px/1.4 monospace; padding: 0 4px; text-decoration: none;
+}
+.readability-debug-removed { outline: 2px solid #c0392b !important; text-decoration: line-through !important; opacity: .6; }
+.readability-debug-removed::before {
+	content: attr(data-readability-debug-reason); display: inline-block; z-index: 2147483647;
+	background: #c0392b; color: #fff; font: 11px/1.4 monospace; padding: 0 4px;
+}
+.readability-debug-selected { outline: 3px solid #27ae60 !important; background-color: rgba(39, 174, 96, .12) !important; }
+`
+)
+
+// prepDebugHTML numbers each element of the document with debugIDAttr, and
+// keeps a copy of the document to annotate later.
+func (ps *Parser) prepDebugHTML() {
+	for i, node := range dom.GetElementsByTagName(ps.doc, "*") {
+		dom.SetAttribute(node, debugIDAttr, strconv.Itoa(i))
+	}
+	ps.debugDoc = dom.Clone(ps.doc, true)
+}
+
+// getDebugIDs returns the debug ids of the elements in node.
+func getDebugIDs(node *html.Node) map[string]struct{} {
+	ids := make(map[string]struct{})
+	if node == nil {
+		return ids
+	}
+
+	for _, element := range dom.GetElementsByTagName(node, "*") {
+		if id := dom.GetAttribute(element, debugIDAttr); id != "" {
+			ids[id] = struct{}{}
+		}
+	}
+	return ids
+}
+
+// renderDebugHTML renders the copy of the original page made by
+// prepDebugHTML, annotated with the decisions of the final attempt in trace:
+// the candidates are outlined with their score, removed nodes are struck
+// through with the reason, and the nodes that ended up in the article are
+// highlighted. The scripts of the page are removed, so they can't change
+// the page or the annotations.
+func (ps *Parser) renderDebugHTML(trace *Trace, selectedIDs map[string]struct{}) string {
+	doc := ps.debugDoc
+	nodes := make(map[string]*html.Node)
+	for _, node := range dom.GetElementsByTagName(doc, "*") {
+		nodes[dom.GetAttribute(node, debugIDAttr)] = node
+	}
+
+	addClass := func(node *html.Node, class string) {
+		className := dom.ClassName(node)
+		if className != "" {
+			className += " "
+		}
+		dom.SetAttribute(node, "class", className+class)
+	}
+
+	if trace != nil && len(trace.Attempts) > 0 {
+		attempt := trace.Attempts[len(trace.Attempts)-1]
+		if trace.FinalAttempt >= 0 {
+			attempt = trace.Attempts[trace.FinalAttempt]
+		}
+
+		for _, candidate := range attempt.Candidates {
+			if node := nodes[candidate.Node.id]; node != nil {
+				addClass(node, debugCandidateCls)
+				dom.SetAttribute(node, debugScoreAttr, fmt.Sprintf("%.1f (raw %.1f, links %.2f)",
+					candidate.AdjustedScore, candidate.Score, candidate.LinkDensity))
+			}
+		}
+
+		for _, removal := range attempt.Removed {
+			if node := nodes[removal.Node.id]; node != nil {
+				reason := string(removal.Reason)
+				if removal.Detail != "" {
+					reason += ": " + removal.Detail
+				}
+				addClass(node, debugRemovedCls)
+				dom.SetAttribute(node, debugReasonAttr, reason)
+			}
+		}
+	}
+
+	// Only the outermost nodes of the article are highlighted
+	for id := range selectedIDs {
+		node := nodes[id]
+		if node == nil {
+			continue
+		}
+
+		if _, parentSelected := selectedIDs[dom.GetAttribute(node.Parent, debugIDAttr)]; !parentSelected {
+			addClass(node, debugSelectedCls)
+		}
+	}
+
+	for _, node := range dom.GetElementsByTagName(doc, "*") {
+		dom.RemoveAttribute(node, debugIDAttr)
+	}
+	ps.removeNodes(ps.getAllNodesWithTag(doc, "script"), nil)
+
+	// Add the style, and make sure relative URLs keep working when the
+	// page is saved to a file.
+	head := dom.QuerySelector(doc, "head")
+	if head == nil {
+		return dom.OuterHTML(doc)
+	}
+
+	style := dom.CreateElement("style")
+	dom.AppendChild(style, dom.CreateTextNode(debugHTMLStyle))
+	dom.AppendChild(head, style)
+
+	if ps.baseURI != nil && dom.QuerySelector(head, "base") == nil {
+		base := dom.CreateElement("base")
+		dom.SetAttribute(base, "href", ps.baseURI.String())
+		dom.PrependChild(head, base)
+	}
+
+	return dom.OuterHTML(doc)
+}
@@ -0,0 +1,68 @@
+package readability
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-shiori/dom"
+	"golang.org/x/net/html"
+)
+
+func Test_DebugHTML(t *testing.T) {
+	paragraph := strings.Repeat("Lorem ipsum dolor sit amet, consectetur adipiscing elit. ", 4)
+	page := `<html><head><title>Debug test</title><script>document.body.innerHTML = ""</script></head><body>
+		<div class="sidebar">Related links</div>
+		<div id="main">
+			<div class="post"><p>` + paragraph + `</p><p>` + paragraph + `</p><p>` + paragraph + `</p></div>
+		</div>
+	</body></html>`
+
+	parser := NewParser()
+	parser.DebugHTML = true
+	article, err := parser.Parse(strings.NewReader(page), fakeHostURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if article.Trace != nil {
+		t.Errorf("trace should only be returned when enabled")
+	}
+
+	if strings.Contains(article.Content, debugIDAttr) {
+		t.Errorf("debug ids should be removed from the content")
+	}
+
+	doc, err := html.Parse(strings.NewReader(article.DebugHTML))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(dom.QuerySelectorAll(doc, "["+debugIDAttr+"]")) > 0 {
+		t.Errorf("debug ids should be removed from the debug HTML")
+	}
+
+	if len(dom.QuerySelectorAll(doc, "script")) > 0 {
+		t.Errorf("scripts should be removed from the debug HTML")
+	}
+
+	if base := dom.QuerySelector(doc, "head > base"); base == nil || dom.GetAttribute(base, "href") != fakeHostURL.String() {
+		t.Errorf("base should be set to the page URL")
+	}
+
+	sidebar := dom.QuerySelector(doc, "div.sidebar")
+	if !strings.Contains(dom.ClassName(sidebar), debugRemovedCls) ||
+		dom.GetAttribute(sidebar, debugReasonAttr) != "unlikely-candidate: sidebar" {
+		t.Errorf("sidebar should be marked as removed, got %q", dom.OuterHTML(sidebar))
+	}
+
+	post := dom.QuerySelector(doc, "div.post")
+	if !strings.Contains(dom.ClassName(post), debugCandidateCls) || dom.GetAttribute(post, debugScoreAttr) == "" {
+		t.Errorf("post should be marked as candidate, got %q", dom.OuterHTML(post))
+	}
+
+	// The post is the only child of main, so main is the top candidate
+	selected := dom.QuerySelectorAll(doc, "."+debugSelectedCls)
+	if len(selected) != 1 || dom.ID(selected[0]) != "main" {
+		t.Errorf("only main should be highlighted, got %d nodes", len(selected))
+	}
+}
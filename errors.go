@@ -0,0 +1,132 @@
+package readability
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by the parser. Use errors.Is to check for them, and
+// errors.As to get the typed error that carries the details, if any.
+var (
+	// ErrInvalidURL is returned when the page URL can't be parsed.
+	ErrInvalidURL = errors.New("invalid URL")
+	// ErrFetch is returned when the page can't be fetched. The
+	// details are available as *FetchError.
+	ErrFetch = errors.New("failed to fetch the page")
+	// ErrTooManyRedirects is returned when the page is redirected more
+	// than HTTPFetcher.MaxRedirects times.
+	ErrTooManyRedirects = errors.New("too many redirects")
+	// ErrBodyTooLarge is returned when the response body is larger than
+	// HTTPFetcher.MaxBodySize. ParseURL returns it in a *FetchError,
+	// whether it's found from Content-Length or while reading the body.
+	ErrBodyTooLarge = errors.New("response body too large")
+	// ErrNotHTML is returned when the fetched page is not a HTML
+	// document. The details are available as *NotHTMLError.
+	ErrNotHTML = errors.New("URL is not a HTML document")
+	// ErrParse is returned when the input can't be parsed as HTML.
+	ErrParse = errors.New("failed to parse input")
+	// ErrTooManyElements is returned when the document has more
+	// elements than MaxElemsToParse. The details are available as
+	// *TooManyElementsError.
+	ErrTooManyElements = errors.New("documents too large")
+	// ErrInputTooLarge is returned when the input is larger than
+	// MaxInputBytes. The details are available as *InputTooLargeError.
+	ErrInputTooLarge = errors.New("input too large")
+	// ErrTooDeeplyNested is returned when the elements of the input are
+	// nested deeper than MaxNestingDepth. The details are available as
+	// *TooDeeplyNestedError.
+	ErrTooDeeplyNested = errors.New("document too deeply nested")
+	// ErrNoContent is returned when no candidate reaches CharThresholds.
+	// The Article returned along with it still holds the metadata and
+	// the best content that was found, if any.
+	ErrNoContent = errors.New("no readable content found")
+)
+
+// TooManyElementsError is returned when the document has more elements than
+// allowed by Parser.MaxElemsToParse. When it's found while the input is
+// tokenized, Count is the number of elements read so far, i.e. Max + 1.
+type TooManyElementsError struct {
+	Count int
+	Max   int
+}
+
+func (e *TooManyElementsError) Error() string {
+	return fmt.Sprintf("documents too large: %d elements, max %d", e.Count, e.Max)
+}
+
+// Is reports whether target is ErrTooManyElements.
+func (e *TooManyElementsError) Is(target error) bool {
+	return target == ErrTooManyElements
+}
+
+// InputTooLargeError is returned when the input is larger than allowed by
+// Parser.MaxInputBytes. The input isn't read any further, so its actual
+// size is unknown.
+type InputTooLargeError struct {
+	Max int64
+}
+
+func (e *InputTooLargeError) Error() string {
+	return fmt.Sprintf("input too large: more than %d bytes", e.Max)
+}
+
+// Is reports whether target is ErrInputTooLarge.
+func (e *InputTooLargeError) Is(target error) bool {
+	return target == ErrInputTooLarge
+}
+
+// TooDeeplyNestedError is returned when the elements of the input are nested
+// deeper than allowed by Parser.MaxNestingDepth. Line is the line of the
+// input where the limit was reached.
+type TooDeeplyNestedError struct {
+	Max  int
+	Line int
+}
+
+func (e *TooDeeplyNestedError) Error() string {
+	return fmt.Sprintf("document too deeply nested: more than %d levels at line %d", e.Max, e.Line)
+}
+
+// Is reports whether target is ErrTooDeeplyNested.
+func (e *TooDeeplyNestedError) Is(target error) bool {
+	return target == ErrTooDeeplyNested
+}
+
+// NotHTMLError is returned when the fetched page is not a HTML document.
+type NotHTMLError struct {
+	ContentType string
+}
+
+func (e *NotHTMLError) Error() string {
+	return fmt.Sprintf("URL is not a HTML document: content type %q", e.ContentType)
+}
+
+// Is reports whether target is ErrNotHTML.
+func (e *NotHTMLError) Is(target error) bool {
+	return target == ErrNotHTML
+}
+
+// FetchError is returned when the page can't be fetched. StatusCode is zero
+// if no response was received, in which case Err holds the cause.
+type FetchError struct {
+	URL        string
+	StatusCode int
+	Err        error
+}
+
+func (e *FetchError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("failed to fetch the page: %v", e.Err)
+	}
+	return fmt.Sprintf("failed to fetch the page %s: status %d", e.URL, e.StatusCode)
+}
+
+// Is reports whether target is ErrFetch.
+func (e *FetchError) Is(target error) bool {
+	return target == ErrFetch
+}
+
+// Unwrap returns the underlying error, if any.
+func (e *FetchError) Unwrap() error {
+	return e.Err
+}
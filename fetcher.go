@@ -0,0 +1,133 @@
+package readability
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// Fetcher fetches the web page that will be parsed by Parser.ParseURL. The
+// caller is responsible for closing the body of the returned response.
+type Fetcher interface {
+	Fetch(ctx context.Context, pageURL string) (*http.Response, error)
+}
+
+// FetcherFunc is an adapter to allow the use of ordinary functions as Fetcher,
+// e.g. to serve recorded responses in tests.
+type FetcherFunc func(ctx context.Context, pageURL string) (*http.Response, error)
+
+// Fetch calls f(ctx, pageURL).
+func (f FetcherFunc) Fetch(ctx context.Context, pageURL string) (*http.Response, error) {
+	return f(ctx, pageURL)
+}
+
+// HTTPFetcher is the Fetcher that sends a GET request using a http.Client.
+// Reuse it to share the connection pool, proxy and cookie jar of its client.
+type HTTPFetcher struct {
+	// Client is the client used to send the request. Default:
+	// http.DefaultClient.
+	Client *http.Client
+	// RequestModifiers are applied to the request before it's sent.
+	RequestModifiers []RequestWith
+	// MaxBodySize is the max number of bytes read from the response
+	// body. Reading past it fails with ErrBodyTooLarge. Default: 0
+	// (no limit)
+	MaxBodySize int64
+	// MaxRedirects is the max number of redirects to follow before
+	// failing with ErrTooManyRedirects. A negative value disables the
+	// redirects, so any redirect fails. Default: 0, which keeps the
+	// redirect policy of Client.
+	MaxRedirects int
+}
+
+// NewHTTPFetcher returns a HTTPFetcher that sends its requests using client.
+func NewHTTPFetcher(client *http.Client, requestModifiers ...RequestWith) *HTTPFetcher {
+	return &HTTPFetcher{
+		Client:           client,
+		RequestModifiers: requestModifiers,
+	}
+}
+
+// NewTransportFetcher returns a HTTPFetcher that sends its requests through
+// transport.
+func NewTransportFetcher(transport http.RoundTripper, requestModifiers ...RequestWith) *HTTPFetcher {
+	return NewHTTPFetcher(&http.Client{Transport: transport}, requestModifiers...)
+}
+
+// Fetch sends a GET request for pageURL.
+func (f *HTTPFetcher) Fetch(ctx context.Context, pageURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", pageURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, modifier := range f.RequestModifiers {
+		modifier(req)
+	}
+
+	client := f.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	if f.MaxRedirects != 0 {
+		// Shallow copy, so the transport and its connection pool
+		// are still shared with the original client.
+		maxRedirects := max(f.MaxRedirects, 0)
+		limitedClient := *client
+		limitedClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+			if len(via) > maxRedirects {
+				return ErrTooManyRedirects
+			}
+			return nil
+		}
+		client = &limitedClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.MaxBodySize > 0 {
+		if resp.ContentLength > f.MaxBodySize {
+			resp.Body.Close()
+			return nil, ErrBodyTooLarge
+		}
+
+		resp.Body = &limitedBody{
+			ReadCloser: resp.Body,
+			remaining:  f.MaxBodySize,
+		}
+	}
+
+	return resp, nil
+}
+
+// limitedBody is a response body that fails with ErrBodyTooLarge as soon
+// as more than the allowed number of bytes have been read, so an oversized
+// page is never read into memory as a whole.
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining < 0 {
+		return 0, ErrBodyTooLarge
+	}
+
+	// Read at most one byte more than allowed, which is enough to
+	// know the body is too large.
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+	if b.remaining < 0 {
+		return n - 1, ErrBodyTooLarge
+	}
+
+	return n, err
+}
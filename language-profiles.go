@@ -0,0 +1,42 @@
+// Code generated by scripts/generate-language-profiles. DO NOT EDIT.
+
+// The trigram profiles in this file are derived from the language models
+// of lingua-go v1.4.0 (https://github.com/pemistahl/lingua-go),
+// Copyright 2021-present Peter M. Stahl, licensed under the Apache
+// License, Version 2.0 (http://www.apache.org/licenses/LICENSE-2.0).
+// See the NOTICE file in the root of the repository.
+
+package readability
+
+// languageTrigrams are the most frequent trigrams of each language, in
+// order of frequency. They are derived from the language models of
+// lingua-go by Peter M. Stahl, licensed under the Apache License 2.0.
+var languageTrigrams = map[string]string{
+	"bg": "ите ата пре ени ето ото ост ред про кат ова ани ста ств ест ния ира нат ава ият тел али нит ане при мен ран раз ват ние ски ент ато тов ина ван нал сти ист рав ове нов пра ори сто стр ска или рат ята ари има лед еди ция ели оди дин вен ден сле пол тра нос ици тво аст гра ини едн ика сте ави лен ана под аци как ком ате ява пос оме рит ито тан тен аме нта ово мес гар алн ена кол тор лни рез лиз дат вър ълг рад нет ник кон ати рия вет ече ора год ови тар оже ери лит лга изи тат тав кои бъл оли ете оит жда каз лно ено мат ога рем род зна вот иет кой тни мин тит ара еме чес кит пар иче сам мож ого тва тър обр ъде пор кра ичн ака гов яма гна ков ако два рен так еле ета уме тер нот ион доб нск елн сиг лко зир дан жен бра нен вит сре игн ита ити спо дна ано зва мно ниц ров гат вор ива акт общ нап акв оре она чен дър аза ува амо веч дни ока нас анс тно мал ско рах ням бил ичк ера мер тур зап раб ежд нти оло рес бъд або дел дно ърж вал иск тро лич час пла лат бот най ект иде рис ала рск лас еск оти ади мет ози цен оят оле кия еде ода той еми дру ети със тве арт тив кан ног пов бор ези три сич тре няк слу ази ица рос еше вре сно нар азв еда нач цит неу едс вод вни евр ржа дст руг въз ико аде вси жав око нци стн ърв але изв защ без дав олк ква еум лна тич ало хте йто чни чно спе кто яко лов уча инс кар есе същ бва ойт ама ада ега шен тря ащо аче ахт чки анд аха ере кри луч нст гла към ема стъ ряб его ябв тин све вер ене ман дос вам апр ког тир чет арс нис оби вин мис поч рма осл пър иал все мит изп пер рно оми нес зат ейс рек они лек над реш вро още едв рти име ход хор пит оет път дъл тта ага аве ант рна сег лиц нам ила еля щот авн кот обе отн дит ващ кти ган фор рал ащи вто кое гол сме орм лож лаг иза цио очн вид съд изб дал оба циа нег оля пом жив роп рещ рни вед коя ято авя алк ции ота сво иво йск вия пъл оде опа лев тия иха ълн лан вар рга лав стт ром аро рам вно гле дет чер лем сни йст пок еки отк пис тру оне сил енн елс апо екс инт във обл сов нно дов чит док тез рие тик лик нте бли учи чна лад ами щат рас тоз рай рич тем ола ъпр ним овн ъст дне кор одн ясн поз аре кво зар вес офи ими ева еща чин одо ура таз аси аше исл ерн сед раж рот тал отв зан рет сек нни рим оно щит аща ачи тът чва зак нев тна огр лст зав лет пад зад роб тви рус вна гор мар меж тоя вят гер рев лия точ омо рва ека реп оте нав ича апа сен ома опи вис рин кре изн жду ило тол рът дад сия пан бщи рак ача зли мир дим сна бол кал опр рик лис ляв въп нещ сел етн пет клю тях озн там урн онт оро обя осо ивн люч нер лят вел олу ажд жно ига вил ела уги ълж орг сла бла едо рои мог опе зда лот съв еро изк чов кла аса май бир енс беш улт аво риз очи паз нац емо къд бре еца лям тка ген мом щес съм бит дар вол зра нич нди дра леж ваш уст ъда пен иви пон няв иси лин жит бач ине дей иве ерт йно яви цат соб вие онн авл сит шни виж енц айн ърн мон ойн ело пот бле рил дор век чат упр ном ури бел рив вка ино ерс пус оси зве игр обс зас асе наг чав оръ бро арн тъп осн яха гур иит игу еси оце мил орн кци сим гот исо ожн тиг ату ъщо реж иса бър цел съо твъ заб бан сан рта анк рег бри онс зем уск изм вле рое анц съб мед ърш лог рок тие еви яка кам сет рац кур бед дес бях нан ежи орт низ вла диш рви дер азб имо изо обо зпо збо авт чев аго ещу изл йна лам чак руп аже сло бен оци соф бур азн рио фин осв зал еги оку аши руд нда лта аги ири уни омп дем йни асо зпр рол оиз зби еко нах акъ олз печ чил апи ург връ адн мвр ърд одъ азп нтр чал дви сва азл зви чко зка уче две луж ъзд пло пас опо есн зов олн ъве зни дум щин ичи рми рка арк окр еза атъ вой ише роя наш уси вск мор усп исъ тет оче осе нка ъоб аки каж бще лив ьор еве бив адъ ъзм рог еци илн съз ърз хме отг дир ещо ийс том иту аря лка нае сем мот отр обн изг мия доп ейн ума бер цял ожи едл зне ишн змо фия омн нак тго етъ пей тск яст дом бав тот уми сяк бст спа кул дец изт роф гия оча тес ида мам оги пак зво рги етк уби соц спр жат инф сир рци нтъ мак ъща лзв сер пир пат заг гас пле тиц ерв мяс маш мни воя мос куп иле здр ута ище дми тег",
+	"ca": "que ent per est del res els men les con tat sta ant ció amb com ons aci tre des una ues ita pre ona ica cia tra era ion par aqu ada pro esp nci ran tar ist any ter nta més ntr ici tes car ame ten eix art ser als ria cio ara ass tal nte sen nts ort cat man ens ect sti ell pos ver tot fer tor van seg tan ura ari ats lla ers lit ina str rec bre por ste arr tic rti tam qua mar eri ont ssa tit lar ost alt act ava lic ora ssi nes int egu ana ren ali for nya ata ess all rre ins omp ime nar ies nal enc emp nti qui aix gra mer mat rat rar den seu ret ble bar unt esc ènc ans rta fin olt cte ide rac dor ome lle sev ual ial gen ere gui osa mes rma ene erò tin tur sos eta obr ili què mol end cal eva nse ner ral dia pas han ade pri ven can ert lan nic one eni orm ors cap ure ori dir cor tem cre inc uni itz sar eur ate vol pan uan cen via ron cas ove llo ida rad ill ves ern ltr reg ado rim nys sit dis ese ala arc ota err fic dre spe nca mbé tza ord col ega ien tac ena mil nit cci der rop rqu min exp cti ani ris ego pod nat lta gon tiv dic dem oci and vis sse ele rit sat reb ixe nom igu ave ema pla avi eco oca tiu uta esa ban iva sió pli tua mpr gir cam spa ive ini imp ind cie itu lor ode cos rri lli pel anc hav mpo iar don arà tge tir ses cta ati ing nda nde rem pen ndi tei pol edi rés ula sob tro sol ane nen mpl rso esi dar cad cla lun cul bli rra mun aba rna tri spr are aco ult nça rer son rib erc egi erq cip rob san val spo ndr ixa cer ete gua ber ple bal gut eme emb erv sal ces ctu len ine alu sid rea gue erm isi ima tad uro nce lat mpa reu atg uny aca nst ire ale iqu rep alg nve tim nsi pré nsa cel sor fet iro ros amp rme ira cri sca ots eci sup sem dur rce ixò nad nis rio loc soc abl met rca pot eus nir orn gar jun obl pec cto rie eny oli bra ngu sis eba atr ram sco gun leg mal són efe dec lec iat oss nvi evi rei ler ote oni lgu stà sso uns ipa aur ciu sab gur onc sic uin alm iss lem ics dif uir ama ifi gad apa rel lia dos orr mic inf jor nov mbr veu ova equ mit dat lls its ivi lam bil eti gov sin bla lac uer udi lme rev fes set dei anç ric nos pat íti ola ore eve lis ref dav fra oba sig arl etr jec uen pal ast ges pun acc sme mon isc aig osi nac onv sio tel din dit olí uar gan xpl omi iut ole vid bat ece iba nia rov rte lad olu uit hor mor iga lon ard rin ope mos rod ela tja rda eda cab òri mis pac ian atu tav cup div lti cin aju cit cur opo ism unc ecc aut uci àri cis cac bri llu ose uri nou abi cid dri ume rve eso nor pet esu omé mpt ofe ife ibl elo neg lít roc ust rga eng ior dan hau ond ben nyo ebr ila vin pon ecu ajo tru uel nim sel emo xen vit inu rup pta enç inv rav upo tòr anv lio vei afe def fen eli oll rla vil cle gre sib xar nfo aga gat rts ald due arg squ upe ixí duc sul rro die fon poc til bon rom uto onf mac rot ami pit dep ocu oma ero var rdi org vel ite adr urs epe yol mas uga rir pte diu púb hom úbl bor exe sum adi fec fun tig itj sec let ued ets fir une uip idi det nin veg ars omb vui xer sto jug nan red gru nec ogr olo ras çar alc uac odu erd uat zar odr ahi eal mad iti hir asa rid apr sts mps rog erà uti uei afi ius íci upa tis mom epa fil ols hag uda nqu imi und erg xem jud nge bit air dim ago rof vot últ tid usa lib scr obe hem lig lid tud ext agi lte lot fal ull oti ito agr iri ano isp mpe tab erè far exi sur opi mbl ger roj odi stu rig ict pis alo abo zac rèn ecl los tec not scu lau icl tma uts ràc dra lim mai uct bai rci pul mir sub ipu sad aça iet abe iol eja omu his cep lei ton bas jar etm agu fam fan irm iur eca oje vor rsi epr spi put cop igi esq nsu gal mod env fac asc pob acu cil eno emi nto nun gin àci dèn maj ibi ecr lca ang nei vie bte aus aís paí ign jan opa ruc lin voc niv gia rça arx amí sim ril ugu ede àti edu asi rol dal rtu moc avu mig log fei lab pci òmi anu ovi ixi avo lav cau ibu lde lts tos cés ofi oto vai ido uma vic ucc luc net ànc onò mul sce nòm nif ndo ous ndu ncl xim efi mot fut món nua stò ièn usi age jus drà but rmi pes ase riv xpe ced gis teg pag evo med bus quí cut hab isa pin rge",
+	"cs": "pro ost sta ova ter ení ých pře kte pod pra ého sti ist kon jak ích sou tak nov ské ová ale ent pol sto ech ick val řed hod edn tel nos str ové ání byl vat při rav est spo kov vní roz nou oli let ali rov ako uje pří bud dní odn ole ním nej ají tra ran kol nic jed lov den tní kou cho ast led ský ste ván níc stu tře pos tov ili jen neb stá dob tav lní dal rod ate ros lad esk ude ího ový prá kla ele vět áln ice ovo cen ani nem lav rad ich ečn kdy oto cké tro len dno ala stn pad lit ovi odl ník oho rot oku ace hla ují vol hra men tic ční nýc rok lid dle alo sko děl nik tom eré zem dos rac vel min dov ede ebo van jso sle ráv por ila ina oje tor lou sem nes ště čes ovn ite cel erý oce las nsk kéh sku ezi pov dou oru sla ved sob měs kýc pok níh ekt žen ohl nce vod vou ven výc ici lat lic mil zen eho pot zna lik pla ěst čas íst osl ící roc eno ten aké rob stí nec avi ete tal stv jej ilo cký ati vše slo nám odi měl res rop pom něj oti adn oko stř tiv hov din kra hle olo jse jší dne tví ode kom rát svě poz vin ejn ame ide ově elk tup pre man jíc něk ika ále prv áva moh víc lší tat mez mus tec udo mat eck chn tím dem raz nep alš roj tou mís ych nen néh och ách aci erá anc ené lád ave nal cha uto bez out ned nad dom ská jin moc jeh ano ini vys vid opa pou tát opr ern eli obr řes rat nap své rom iti eri odp nás akt omo ove ený avn obo šen inu kor nis omu ško bra odo ion ást ným tan něn zák žit etr tur ero ena jem ění aut rní ska aby ože oda pak dop ylo nev krá ací ivo til dpo emi kem zas lem odu ejí tek maj sme obl ana rem dyž edi můž par emo ané ane chy edo dlo stě tar oup rvn ěla řen nut řík věd gra vla véh cov dru hrá nit raj pat eme ůže ori živ obn ějš yst vlá edl áte ora ozh lán iná íce eln liv and chá ust řeb aro ená adi dně čno vil cíc eko leč eji lan evr mož des vit bor než lně ším slu for náv ivn oci ruh nez eto jic nam čen cht tin nci ami ožn ává tru dst ava pen řej avo ito jsm poj rán tit zná orm ysl ela iny rou uni dra sil ešt kém dok tik zho dit ými ome kam sam iál tem atn rez use ant trá tis kal nil dáv tvr poč vot ažd pøe upi ote ada naš ade met vro rál obě nav něm art nte itu ner tál yla chl áro eti zač sed mar raž ere mov eds okr ene adu aví zov ino dět jde tém kým olu mer měn ens spe rác vým bil dat voj one ver kde asi nan síc enc vsk dni ric aly los ono dil čer vně ček řad ram oro hou rma oud ekl pan ady ádn elo iva onc ato lis evi ému sté nto oni nár ard ětš aný pop kat isk mys být kdo ejm ona ach aje tsk šec kro nta dál oby ejv ank omi uch lož rit řek íze poř ber dvo era mno ješ lek les roč rus ces áda mal gen asn per poh nál sch zah chu nst otn ers atí kud tně vaj toh nky uží áme amo zac dné dva usí eži noh div čně elé dla lep pln vyp mén nom ese nat teř teč svo iko ntr usk int idí kan aze fin net kaž tři ném rch ren uze átk ema zal ktu kup zat ouž kti lin orn voz tre ěli mín ouh pět log obc bní tří řip sov bli opo ovs běh zam výr aji avu ačn tka rsk zor eda zid uži rek chc nak oma vyh výs rah žád tot ine hal kaz omá íky adl eří esp epo fir spě ávn edy eba išt ahr ozn kli tvo vyš naj říp øed kut hro zni tky del žil sel dná mít erv sna hem jím ola lém ház oso tos lev rak aco čin daj adě dis ouz uše eče sty íme oze kar zdr ouč tam eni vít ort cie tuj jis hno íte eny záv čil set etí šíc ebn díl lsk ont oji hce run již řil nek chr hlá eje pek šak těž nab ciá vša ští not zku ípa ezn čás zaj ins ara tol tné tud byt iky očn ods lon svý hor obe ute áno álo áze obi rep áko ouc nul ama pit řel ogr abí ily pis íci isí zap mos rně čit nti fil aně rum tší tej ous vám žel eře rol aše árn inf zpr býv zav mec ota akc hyb ebu brn sně ted íle nič řád epš cko ron vém žív spr tož ody odá vra pøi vac fot ari átn ákl iza apo boj drž ory oln tli lam ěko isl ase poc lko rec ozd láš lež sní mis lil řit inn jek ore sky ris kce rád abi ozi odí děj plá jev ops otř dej ávě rno iln luž var uve soc nác lio káz eze irm cká vál ubl iku prů kul top pal veř bou ičn ápa upr kos tku uho hlo ond boh opi táv těj bot izo oná mot ita íká byc are edu jov ves",
+	"da": "der for det nde den til ere ing ter lle and ger kke lig ste med nge ver ede ige ler end men gen ind har ikk mme sen ske som rne ern tte man els ret ill nin ens age ent ang ive ska ner kan var ser sig und res est lse vær han ren mer dag nne ker ove vis lan ten del get ion ene fra ist ære igt kom ell ans kal rin jeg omm dan vil ers rer eri dre ort red isk lev fte ide nte tor vor sto hed ord ale lde str sta sam ati sti old ble tio ege hol ors liv tal ved min eli hav ore sse one ven all bru tid tet are nsk rke sel ndt øre ken lin mar bli per rig eve hel nes hvo enn kon dig pro ber fre ave ris iti kun oli alt ise rug lli len art dst sid nen rst elt lge led ogs amm lit ndr tag gså des rde eft gan kri rbe lad let tig ele før nse nog sin mod bor bil ine ngs tis hun ade vet org her gge nds elv ark åde tre bes par god kel hvi ald bet oge igh pri fin tra rte lem ghe ran gte spi ett arb ert ons ejd pol ude orm bej vin jer mel ted sda rre pla eds rem ess kla tik sko rie ket vid akt esk yde hen att køb net reg rli int eks gør ekt erf gel ole ørs ate kti ant ass ben val uge avi alg ppe tan skr sku meg emm tur rat rge nst dem eng tin kol kab rti mil fle gle lag nem nye bar ann rsk tiv ned ode run føl jde ier lar hus irk gra ids pen idt bør når mpe ien tni tro tat ænd stå agt rel ite øbe vej giv vel jen ali nal nke ørn pil ndl råd ges nta sag træ rik eje ast mun bag æng tem nis kul dle sat hve olk ælg fol met æld sik æll les øde rfo rdi ærk erv ina nat ags ete lok ets lis lla gru rød gti ugt stø cen uds odt ndi mes dde set vde sæt riv rme tør ori søg mmu aft unn eda van ffe dse fal rma ane bla nce ølg lav gne dri lke bed vir mid cer abe dte hje ung fri tel din rsd avd ldt ram kte ski rse lid vad sla tie æst høj rve øge orb anm sty avn rit ads spe uli hva erd går tes lie sit ked oka enh eni nma mor une dli tru amp son mul ækk kra beg ili ætt ron ået ard ves ilb sva nor tar erl læg ins syn mis jæl lte lys erg ldr era iet mål sæl ari ank far eme eta egn kre ini aar adi ørg tyr ban nyt båd tli ull ike las ytt por kam ple dis fik mat san dsk ure øje tri ost udv ont ræn kro rda ire rup sal ssi ult dba uld jem sni mus kse erh ial amt mas mig orh ræk ses fun rts top ykk ami ærd kni lot får lsk aml opl ame løs nda kor tår kat rag mød fly yst ars dvi rks spo sky raf ift erb iel use hør jor yld ika æde lut løb læn sor ask slu yre kvi bol ejl usi tæn dom oll mle ogl ime gre rev ygg idl iss rop præ akk ile rho beh kli rad næs lba tæl pre rol igg edi rek nel ild rod byg ntr ntl rud sio rum ian log emt lov oto eld edr rtæ ita svæ err dni ikl ust ånd esp lta sle kær gni rak nie lær læs rob ham æse erm oms kst idd hov øve bel åre afs mær ynd emo efo cia hal rsø ork ful pet ona dsa pos opp rog ara dra atu kar ært små ukk ule rso uti ænk omi leg ivi ørt ils tad sek nti hos utt erk teg orv spø bud dda kle kør che vne jul spr tje sli irs deb dat bre åbe ygt pør blo erø sho ani dog bri kræ off mag tyd nli fan lik ala cha sma nkt bef obl orl gla ras die orn ign omr åri bev gst lej hjæ ælp ræs øns nom dsp ilk alv gsm tim lst orf sme gav mrå lil uni bra sol the ndb øst ral fæl ama rea mad itt emi stæ fer spa arm lti fes mti ono anc nsd eti smi eba fil ogr sis fam tir fat soc nok oci åle nha tol vik søn fær hån ukt idi ote tek arr ørr åda vig ora nsi uro egi ebo skæ kil eur kin sær fen kur his udd ærl fir såd kes mbe dta nan klu mal øko ror smu tog rav evi unk ryg mst ægg ott lgt roc gaa lds udg æge mli kas emb oft dov læd upp pel jds eha dva ods ats ema reb sun chr gde års ønd stu mæn rhu nær uss pas arn ægt efa nfo hri arl esu tab ejs gjo stj ref yne fot rvi rkl tia dal ela mon rta tti fas åsk try als rim vat sem bat ngt ged lam gam olo kso ilf yse ops rna røv ård app onc væk edl itu olm ejr ese rsi tak udt omk tøj ryk rej bro eto ibe rus nni jek tas væg hil ilm vre ice hæn yll iva mås sfo oma eho esø ødt odu fåe esl prø luk dit ldi kva rga iks eru tær nve eml oce ety ond emp tit dir eva omh rds ros igs urs vit lio bek rid ems tho ega egy ndn flo hin græ vol svi åne",
+	"de": "der ich ein sch die che den ten und ine gen cht ter ung nde ste ver eit hen ber das nen ist mit auf ere nge ach ren ers ent nte ier and lic lle rei ert aus rde men ern ben bei ige abe von sic end sen sta uch wei sei ner ion des ges her sse hre für sie isc len ass ger rte ind dem wer ite all nic vor ang ell och tte iel est ege wir ing run ese lan mme ann auc ens wie nac als ahr oll tio erd lte cha hat übe lei rst ech ies eis age ien war pro tra tel ler chl art man zei fen eic ehr ene ngs hte nne lie hei ati ebe eri ede rie ser tsc etz zen tig unt eut uss tei ran ort itt ele bes str tli ete omm alt kom eil mer nst erl ehe enn erg elt ins tun geb sti eru ess sin hab gel ken tag rau one tet erk spi nis tzt chi att geg rge pie kei sol lin kan ric ied erh int jah vie esc hal rbe ate ide haf ill kon era chs ffe nem ihr erb nnt iti rec tie wen ode fra eig hin hne aft noc eue neu anz for rin nsc tre son ant eur geh rsc chw ute ird ini res meh deu erf hme tze ank mal rch gan spr ord akt sel rer per nie chr han cke gew imm zie mei ris fer tar rne chn sam min rat err erw zum uro kti sag bis gte ieg mar lli hie rag nze llt ale lau nun hau tan sst lun agt ans chu ück ise kön was hri tri uts rit inn ali zur fre wur its par hle eid aut nur nal iss ick are urd oli tis zwe pre änd fin önn uer nat ssi ina urc bil dur wor arb lag stu rke eme mil tor gli pol ons nig eht dan lit mus reg fah ark igt pla dar las net ona wel nta erz ieb rli ahl gef dig egi tal erm fal uns org sge let eld eim bun ker mac ähr rüc kte gie off neh ami nse cho amm ame lig seh rig äch zer set tro tat tes ehm nke hla ndi nes bet leg hon bar ekt ust eib ble ive etr füh zus det fte onn unk rze bra fol aue enz orm nkt tik gem ita tiv hwe uen ast ili ohn weg ntw tur atz olg roz ibt ont kun gle lat lis oze gro wis ewe gun nts bli doc ena del stä the ühr ett ond gab sit inf rre teh nan nfa ild ost rti les edi sto gra dre üss ors tät twa ema hst dam mon win rem ntr ani rts eni lde ade ara rha ban wic äng hun los dun ote bst itz bri eck zun kla suc hts wil gru rma nti chä kri kur ari gut lem por ard hti bel utz usg eie elb inz prä woh kel gri enk ßen rla uge usa jed gar hli zte mat län leb gre bau din rga ize eng rwe tin sat sla mpf kra rle rhe zah nah mmt mis kam spa spe ntl ore isi use sun lar uft üch rop ain rdi nut lüc eln obe bew alb ile htl abs ppe lge tim wol rme nli ufe ike hrt roß tem etw kau fun ndl rac rad dat bek lus pri lls rtr vol nds app dor fan gib itä ahm amt zug rkt beg kre fac har ibe woc oss enb ret ana two els sor ünd ika spo mög bal san ori iet bie kle ieh uto sio pfe liz esp esa anc egt ela äre flü ats mel ewi äft kin raf itu vom hör nsa hem reu rsi ras uhr uel tad bur wür ral rwa müs ögl eko rea jet esi rai rse ros tzu kli wäh twi rot wal aat gst rif sis stü taa rob ckt ton zwi pen wah oto aff erv ieß äte ktu wes fel nzi fas izi hol rum ums abg fes wan bed rmi ude uni ruc dis iff rkl lch nch ndu ink red ilt aum jäh ßer fts mic rna lio rfo rfa ufg tür fri urg tge dri ief eli räs efe ram ohl tst ial nel ehl rfe hul irt aub eam bre nla ven chm get gin ürd adt lla heu teu pas ack enh hil dli hlu ihn mas nsi lbs swe met hlt nve nbe tec ses hef lär rol mai eug enf rus igu bin oße ebo öff mie nha pun urs hel ätz nsp feh bge nau mun log ure hät erp oni rün irk tsp uck enl sid tle gek hru beh mbe ünf uße tru grü dab sem lia wär sbe ält ngt amp pra eiz nor hes nft sha klä pan nit häf kar ose eka tau ube zeu bez oge inu esu med ckl bot flu ehö ama ail pos eif kos tän yst elf kün nga hse ome nba ütz eso qua ref sma jun hof ahn ock uar ärt urü efa fli ega ltu ule rof mod upt grö asc ian ukt onz wac hni lfe näc kat trä omp ane chk ndo äge sow eha rsp rek urt ssa nom nhe ähl lbe lös eiß erä ndr ora tit aup rso lam höh urz ätt emb tär ehn odu tea usc adi nda uti nfo eta upp rog ire unf twe arm rup ima fti eff vid eze sig hoc bef emp häl äsi nzu rüh dro ürf ufs iec nma igk rod ebr gke mst ron opa oft egr rsu rik fge klu äss mes fäl hän udi sac bru eno ume uli orf eih osi lsc",
+	"en": "the ing and ion ent for tio her ter hat tha ate ati all ers ver ere are ill ith res his wit thi con ted com ear men pro our sta rea eve est ive was out nce ome tin oun ons you ave ess one ove per ide ect int art ort ore ist cou igh aid hav rom ine not nte ity fro man sai und der iti hin ain ste par wil tor ght ant str can day tra pla din ice pre rin cti ame ies han nts ica red den has lin cal end oul sti but ast eas rat rou ple ard uld oth eat tur wor hey use min she age cha sin ust ran por hou nal lle ble ree lea mor eri een ont son nde ren kin nti ber wer whe rec unt ake own lan ven era ure tic als yea inc act hen ind ead anc ell ces enc tat sho ugh lly whi tim nin nes rie hei ost sed ime sto ssi ial ack ric uni ose ite tho eir mon any off nat ins who ass ten ona lit new tte ous lic mer ner mar ern ser tes che omm oug cen sid les chi abo eal bou gra ope hea tiv ina har tri eme sit eco ong ade spe ned mil ans ace lat ese how ery ire thr ded now app ase ach sio ork dis ral nit oin hil cia omp som pri get tan pen led ich ini ord ndi car ele abl ntr nge lli cat tal fic ond way ood fir sen win rit ars ook oli mbe ali its hic bee oll had ene gre pos old cor ang las att ays ile orm rep cho erv cre ori mat ris tar ike low ish lar fin ves ens tre ari exp lso vin nta sse nto fer ian war ert hoo eed mes fte des rst wou ary ffe ien sch nst usi shi ath ote rti wha owe eop esi ses ili rac opl ark hel ton peo eli aft ail pol sur wee med pec hes ors ani don acc see ett cit nds mpl tea ffi edi emb lay tie isi ici lik ger two hem ual ool uri vel iss sea hos lon irs ngs tru lis rai ild ise jus rge ues eac imp ece arr ivi gro ude nda ult ron hom sec mak ved bec ick rov gin los lac stu col rce rel nsi ely ann ign nne say vic duc gen tak cer uch llo lie ami spo rem rch aus rth eci bli ana ppo ale sel tro nis rte itt ita try loo ked loc tai urn eca len mpa fou clu ubl mis ful pan eti rop tem ict eet cto nci nor bac eek ges ete mos vid air ria hol unc wel nee cam tel ppe ret fac rvi eth hed cau urs vis rma alt rig cle tle riv arl hig rad amp hro omi let tud sup til reg kno dre oss uth eam sou fri row arg nly dow dit rne oca atu add tly uti dec ovi mme tch lec bil onl may lif leg ara ink ean bus dat egi hal mit wan yin qui rre dea dia met mem bri ext cte ein mai ced liv sha esp rke bal ize adi ram cla did rri que mun sts rts nse pas aga ula cur ema pea pub uct rid eni ban ied pin mal cas cke hre ura ory xpe ock gai ily arc mus ros rse bet rio emo qua erm mmu hor inv ncl bra ank eng nni rta iat rev val ute bro cri erc gan nme bas ife sco orn ker sig fre fam aso awa clo onc ida too rde roo bel avi ket eld ept ole iou cul upp ull ler lla ora gam tia hip evi urt elp nic dge elo tow pon fun ima cus goo him rdi arm ega lud emp ash rol rni suc poi tit uar wat ogr spi equ sis mad inf gov sda lig opp efo cce dep arn vie cco top pat san rds inn aki aff asi bor wed nve ken rme nty nth roa lio dic rob inu tme lot rly ged ato ney mov del ifi run set org mea udi hur err rog ela epo oup ews aro cro lia oes mmi rve hot rna hop ask bei mic put iff gar urc epa lls uil tti ets fie giv ref isc ama ano sic dur rso die hap sal pac ped aye roc oti edu ttl eig osi dev pit bot soc pai alk rot fee ham rod lth sol olo dri nch ono lem rty cra eep ssu foo rag cli ntl erf urr orl tab nov dem eak eer cts vil bar oad jec lor pic pho mot bef goi oci ede tee nfo pti nio ier wal lai law umb tou bea nan aug cie sat ane nue imi gat oke far ior kes tis fil ctu hit spa oom lve bre yer rsi bui oot nam els bur def boo mpo oor rib hon ena rld ruc efe ibl itu mas erg ley ecu rim tec dan rnm net tua atc odu oma vol oni sla pet fol log cin bes eff nig cil aut ndo dif tac ala owi lop van mou muc eta iva dir wes nco ems sma plo boa iew uit iel aci vat amo cap sev rus olu ngl cks lev ung onn elf gle emi sue alo sam nsu flo ssa fra fai ees hir ila wom nou vot pli sib nom mee ape sor mpe eel orc onf big cel ppr nag tom lov nec lab cut gue nex scr etw ott doe sum adv ump ldi tag ams tol nia exc esd",
+	"es": "que ent con ado nte los est res ión par por sta aci del ció ien ara las tra per com cia era ica ero una ida men nci cio ant dos des dad ion pre nes ada rec one ido pro nto ndo les nta ici ier ist ntr and enc ter ona ran esp ene ten tar ron tos más ari ale rio nos ina tad tro man ras qui ico tes ali mos end ora uer eci str ros art den der tor ste car aba omo ont ita esa bre lic lar fue rad tic sti seg ios pue tan ser cas ura nal ren nde emp gra mer mar dic ana ver uni eri rma ere año cer ide ner int ade ese dor ect ons das ore cad can son ndi ers cua egu gen min tre edi sto ría ern esi cto cie ert tie cho ria lle ble ace ano tas tal tam nad lla inc amb rte tiv pri ues aro llo ele ort anc mie ial mil are for sid ame lan fic eso mbi nas rar hac orm rac ens iza cos tod cue cen sus ill ema ena nic ece uie uen ili ven nda rti omp cha bie nti esc asa ond spe hab sin ede pos ori cal rta mis cam err ami ces rea ued rim und nid ime dis pas emo ell oci ome sen cre gar sió ber ata isi cci cor odo ral ega mun ños nar nue ech obr ias gan dem lid sar arr act ast eco rid cid leg mpo ual reg mpl dec med tur mas ani imp ama hor ism tid unt mpr iva uno abl bar dia otr uda ela ini ará imi rso rre sal eda ala pon eno ino asi gun cul ono ban mbr arg all rop stá eva baj han exp bra erc uch uro dio sos ima erd uan gad iem uev pla eta mad rat rab ivo ijo ula ate ién sol spa fin amo ito oca ase alg rse vis pañ pol ses col nsa ric liz ing did ati uel aña tri vid imo nac olo cla smo sad ind erm lad arc lec rno pli ost unc lon tin ete hay sit vie osi sob emb ian nse gur dar mpa pod dij ato omb sca gui ust ego rca día igu fer bié rra len zar bli tac ejo ecu oli aja abe deb cti uto pen omi nce ole die nsi sas nco itu ivi gre san inf apa rep tim sis lia has duc aso ota gua lta rna uci noc ola tem tab dir eni cri ifi muc rqu rem esd cip ret sde lac adi ult rro uga gob iar rán gún pec lev rev egi lem val evi ibi var onc equ amp efe don ris tua spo gue aís nis nza eli ple jor paí ram sig eur rob osa obi lam arl rie nst ord ive aca ine red eja jer orr eal sio sic sab rto ayo tir nfo abr erv mit nve ane rri atr lib emá ins zad fre cin pac iti oce eme iga spu adr dur hos ile anz acu sie rga alt rit elo fra pal clu cab nca mes ard obl cur may tel ars muy mpe rod nun nor ogr abi bil bla rda rin aut ice sup eve ebe mba soc ode eña met udi ías orq erá uar ctu bri cac dan rgo ipo mic cta erí lgu exi evo yor rmi uri log nqu cía aho oda che así fun ote ajo oso ope egú chi ueg cap oni eti bía ocu alm sec rde lis nen aqu ipa ira rol iad ían fir ecc sco lit señ inv mej nan rel mal mon dif luc aje ref rdo isc cel ovi lor nec íti onf tán van ciu abo mor eje irm poc fec usa etr scu rom abí ves opi lig ext ire iud vol roc olí oco sem her ton rlo tit bas acc rib afi ncl rme oma oto lme vez ibl vos ués eza lti alo vic ume uir via pel arí vil jos nom ior org omu stu jue ndr rot odu jar pes rci rdi til pué xic uta rce agr hec pie idi pun uso dió oba sor cir upe gos onv upo cat rer xpl mat pan rup alc bia det mue ncu mac fes lab odr bue olu lin apo emi aza esu bor sim uid ald aun loc ans ulo vas ler aga ila dej púb úbl viv isp edo ago lít pet ite ólo def vel uma ife rge bro sib cis cit inu anu rsi tuv ove cil ave rne och tig gas jun bio xim lat eño sól efi sum dep uti lim har bol neg dre sea jug gal qué usi lea unq apr icó iri opo alu rig sul rov tru tec niv mod rog igi pit ben egr ich aco gru tom ecl nio din eto ibe orn rvi eng avi uip cim bus pat fal drí ubi bal age hom arm ebr últ tud aya ies agu reo vio pio opa sub nia cum zac pul ñal vad nif lus fam sac igo pad lca cup uct zon nov ced irá pid plo nvi hoy éxi dat nía aus epa ige niz uje let mig cib ear olv rav onó isa eba jad erg ava vec azo nat nso tió ueb bid usc oll edu lug scr fen epr nga ped nsu rco sla egó ayu dom ril ñad drá sur laz dri sil erl blo ead spi ngo odi not ang ibr rtu atu opu nin zas erz raz dam rla pag ngr nam uac ong teg his ree ofe riv oro iso nfi ape eca sia rió enf lue mpu avo ign ntó ict ley cep pin dal",
+	"et": "est ast ist ise mis sel use sta ust sti nud ste val ees aja ava tus lis aks ali ele kui ole nna oli ema eks iku end sed lle lik või ime min dus saa ine ell ing and ata eva ida aas aga ide ses nda ega atu maa eri stu ama las vad tud kon ada tsi inn ami ita tas ate ini lin eel kes see pea tul oma tse tee tel its koh lus all uur lli vas sid ale kas esi oni alt ima tte aal lii ule ase iis eda mee teg sii elt les uta tam ndi rii kus ool eta sus kor pol oon inu asi eid lit nis tat igi mas eis iga tal tis töö ade eer suu rah anu tes lla nim ene mal sse eli imi aha kul ile ari itu ala mat des väl gus tei nin sin aid sek lek koo ots tav umi mus ahe dis ent ndu rit mes tsu lja isi ani jal iig ame lem ter sei kse tad uid tun mil kog juh ris ite emi tea isa pal äev met aar ras ika kku päe kir tab ult ane mei ära asu ind ogu kõi vat sim res tak era ost lda nii uli sam arv alu roo uma idu ald esk pro ähe etu usi nik aad ako htu uud egi ili imu mak tle loo ikk vii nni are ese par üle uri kin iks ngu ill das ete võt sio ure ina ead iti õig del oht eal ike aan rik ett nas ati vee ngi iva aat van sis onn mid ilm aeg pan hel rak ela tan uba men iit kel vai ord ond nde mit uht õim pär sea seg alg kuu utu ege jär var soo iik ioo art tän agi oll jää ara hin eil poo nem taj ude lev nne õik jat sal vah unu ann rra lma älj ess rva eld ige rem ske rim adu sem ain eed jad dam too hen tek kül ood tag jan eni lan gas eti een ulu äna õtt sen orr iko kka jas ang kaa osa isk usa kom tar tik kun irj ena muu rid uro nul iri oha eur äit ant oos egu teh iir ssi rin sõn ahv nen taa kan maj uks una kah dat raa oom tor sut tri pid ere iin toi uus rja tem alj hal lat gem dal lmi uva lju nat ven oor kat sit uda vus ekt set nei vis mad uul nit sil ori tim ehe ats eem ilj oot kol nõu ran iss gel ksi kut lnu ute sma aam jut põh hak üks kee man käi dad ral elu eat eet ren nag sko üüd eie uut nts tid pii nad enn leb ühe str hul näi stl sik rma lid und ass eme kal pra pos let len enu pet ütl ril jus ärg äär tah omm tlu kau mme ana aab ves mik rel jõu aus uko olu kud iid kur agu uni mul odu lau nam äli juu rve tsa tii elg tuu äri adi ser jub õis gis ski per hti lei üsi hva ism aka udi ree akk laa oim sak ksu lim ait lga uti vit rgi mär nel ään net rat aba udu tum iim pre kum oop hoo nus igu lgu kti mah väg nes sku eht usk ühi orm kod vaa lõp aru vab rot his sia leh tev itt pil ein änu vaj väh jon ovi õnu rju rte hta rus nav ort tur lep lse ium mar unn olm ija ede uss tuk kai jak sul lah ldu aut ööd ula oov isu täi nal oln ber nee kar rdi sõi võr ugu eha naa ndl leg den rää sai akt läh idi noo nti okk uto sva ull dag rae õit kok lee hea üll ets dan oks abi lge eko gev täh git iiv ker aht ööt meh eak het det nte mel tut ais asa sja kro evõ õib äga eku hte opa iki uge lel dab uts tla küs gud ikl avi tli lam hju uke rtu hke eeg oda äda nev ont õud til aku dav dla bri did amu kis ska asj lub gil puu uue õhj kli rda hii väi sas uha lap rek ail kid õpe jaa õid gut pla alm ire ask nüü oet ksa ttu vär mõt gik har mõn uhu lal õus han aav kem äbi tmi joo roh uga kii rau kri erv llu apä kõr tin evi rei õne asv dud pak lts nta jät por uvi aps amm nge hit rge ver ked luk toe kla tre läb ärs mai lgi män ehi ota lve omi tra usl deg ääk bra vam ldi nil sli eab iht has spo nai vik äng mbe rvi ome sat öta ndm uka ivi als pin lug gul tet usu alv lav põl vil mmi ahu rmi õpp äki emu lae uru pse eka htl äik kak nid suh kad arj aig san hoi ulg tja lut ohk sav daj ljo kam irm ärk huv smi müü ard tta hem pik puh õnn lar nnu kav hat mer ohu kto dik itl paa näd lih ilt aak eam tõe hts une pai ägi iiu gen nek kts gat one rsk äst suv hek hus lul erg ääs ook api tme nan lig ner sad ion eki mõi dse äre epa üri sum vää tub pää aai aki gan ien efo ume ial dil for epi dit kra aot ppe ore vid äht nve avu hil lje sug osi sep pis gal uhi evu nga tit rti hes õrg lai lum bas itm eja ila ltu nsi ank ref iaa uju kuk oob eit oid rol osk aim ehk ias tnu mbr süü esm üla aud eeb ted olt otu med int fir riu mut edu jao",
+	"fi": "ist sta ssa aan lla tta ise ett taa sen itt een nen ais ksi ttä all isi ell lle lis ill ast est ine iin kse lli ste den stä ain mis ään aik oit vat maa oli utt ust kaa ten nta sti uks toi tti lai llä ava tel kin ikk oll iss min tte kun tai ess kan val ssä itä tää aja voi sia ala ent ole ois ita ien men nna kka oma ott ses ide kai uut vuo suu saa tee sin ass ika lii eis aut tii att vai stu ina kau suo tei int sii uom tav eri tun vaa unn lin sel ite tus tul ost utu pää ali tet sit lta ant nne oim nki eli sto vas pal ttu imi äyt mat taj kes ans aat set kuu itu uol ila ytt uka uus ama tam oin vii iva nee asi per ann aal iik ude nsa van iit enn aks yks tar unt rja man til tuk iel muk mie kir pai joi kki elu käy tie see nti ana esi san ova ime lee hän enk uot ulu nyt alt laa muu sis työ myö kui rin nut sil ken tal ike äll alo lan ens inn isu sku lit uva ker mit esk yös lut ämä mal ilm kko rit ivä hal eet uun lma vät tan äis nii nni täm vie iko ari ene oht hte irj tto kas jan apa tuu kon ton elä ami nte mme emm var yht ail yvä hti ter nnu oka kok oss ele koi isä hyv ity atk äst pit jen jat tis alu hta sal tuo ome ano mas eit osi nan puo han päi nis eks tin las uud nto ran iis ark des ati oja its ull eil hin eur eva ata mää lev täv jos alk ert kil ait uss omi kal sty mut par kos kis arv ris pel sek ima uri aka onn oon hde aki ori tum ara tse ävä aup uin loi tak del kat mmi eid hen ote läh osa oko kuv ito usi uur uon hei kol tty sim ule erk eht ver tap aus ink kee joh tia ode tek jok kei äks kel jou ski rkk isk ske len iks uis oul vel ake uta imm mer lei lue ija tka nsi uto oti kii teh net elm yst ukk kou män oik oks umi elt ank ahd kul uor iku mai kus arj nka sai vin äiv raa ein ivi rki mma ute äät iet tys one pol hel llo ees nno llu ema aas ola ena ska äin ilt ulk äne ase oje lmi pun akk kor väl mmä hdo ota noi ini eik jär tut ura jau ehd mak usk ikä huo ntt tän auk uod koh alv siv rak lus sää ätt mik vit opi ilu mil ihi tom ras kot seu ttö evä jot aku uma rjo vis mar oid aam tyk tki lau jon sem kie aht ntä tor kku ili kke ede rik oil isa sik eta aav nai luo sam ona luk ete ohj ian kea rvi iti eel tur iim eni tos mon paa yri äss nos mus kut stö tui lun ouk nel kom kar rto kää une avi ngi syy enä osk kem pan mpi iaa yli upu soi nin ies äjä avo etä uli aih ino jaa ltä mes tas lka rus tyy ela äär puh aha amm naa uok ääs lve rat met rii uro ira ous ane äli uul alm dis jal väs hoi onk tio ssi err too uos yhd ppa ähe ani eki ont jäl lko ulo lem väk atu lme moi nal kit nes rje ros ntu opp ero ioi vän yty tyi eru ihm tok uit mei rei inä lke ven use iso vir ose toj äri inu lop har pis rke kak rve anu jää näk erä keu ria vää nit det ärj nsä tik oni hmi toa yll iki nat let nss ale tot tol mui ope uee ihe aji etu pro ymi ljo tua jes koo lap sei oiv pah rta asu lua olu ämi haa hto emp ako sko tau tyn rva ppu äki täj aar gin lij iha dot siä het äht omm sva una eut ijo htu rra emi kyl anh yön iir utk aaj hee tsi ekä aak ion yöt olm näi ivu poi änä ket pie los ärä mah leh ksy kys les yis äsi nei nas ril uti sie täi väh eto rhe kij art lmo lti mia täy oku ias ask opa sym lia nom aud lou sat imu liv asv vil unu älk ier ula yvi hdi are nty jät ajo sij ppi sak iht lok iih lon jak säk nuk sun yyt nus elv dol ato änt lik pak sio ilj jul ääl ing ävi rah nko puu kia lki iem läm kuk pys kev uja uhe uku luu ivo otu ats ida ynt von lak ruo uvo kiv ksa uki änn era tös oke kuo ate lää ilo rip apu näy arm htä myy tim täl rko out eiv kah opu lah nim sar sav emä ung amp ult tiö jas iri non rka käs kik app rvo uha rma nuo lat läi alj pet lvi ytä pin äke jel aiv ied tat uke rau kim yde yle poh keh tuv sop hit ial nem irt ätö ymm ääk ltu lpa edu lui ilö tit otk tiv ely nkk ähä rsi pii kär avu nha ipa ile tym luv ysy ieh mäi okk itk inv nää eke ijä sas jav syn res ärk upa lip asa jän kym säl dus hak ort eud aid vak eti elk ehi uht kav lil nou uuk äil eja ets vap tke sot vet ren yky evi esä nak unk erv elo ons yys iat löy ron lie ilp rot hdä ere nnä nyk täs pil tod uvi",
+	"fr": "ent ion les que tio our des men est ont ati ant par eur con tre lle ons pou res ans eme ire une ien ait son dan qui ais iqu com nce pro urs nte ell ous tou ter ain air sur pas ran ill anc onn omm ntr mme ier ouv che tra ale mai out sse nne ité ist tte rai art ort tai tes ren ine end ser ure and int ssi aut pré ers ten uve plu lus fai ett ins oir ère ver ces nts cha enc nou aux cti ess ave ens ass ise eux ect age rés ble pre ite leu iss ois rie iti ste ven ris jou ali ses ava cou rti ues sti voi tan ern pri lit man ond tat per san rat por éri nde ute nes ide mar sio mes cet éta ive été nti nis sou vai for mon str vec pos nal sen teu min eau rem tie app lem rit onc tro lis omp uis ert rou ieu nta dre bre ièr sit fra ron nat rès don lai mis den lan era ndi ici ini tiq rte ées lie act ili gra mat sta ndr der oit abl ssa lon ita ica uel uss emb née tem tur oin all nda peu éra ina nse fin sem ani emp roi qua uni rec vou déc rme ard ann uit uti err rta mil cer ang van oli nie isa pla vie tri tit ign rop ate cor dis isi orm ric mbr ési ori ari bli ils att moi avo utr oup ime ace ail enn aie ona aus ura nom emi acc cie nco deu tés dit nan sai ice sid mer itu ler pui tiv ême imp éco gne nst cel lor eil roc ors nté sui pol nem ord nsi col pen cen tin cte mpl ule nné pay tal ubl ara ret mêm gen erm lli prè ial non rep ése cat rne erv dev ner esp ger oul cul ral fic arr bie êtr rni car arc ema exp mie dir erc squ lui rav cia vis soi ind han use rce rer nai tis lic lla elo ult ays oci not inc lat rre ppe tic met sie uer ore gue tif spo ple mal cri éci nna ffi omb cla ose jeu sat nci dép bou dem rap rna ole ame rch qué aur fon iso nge ile ami rma mpo lec ena ivi vel ème ges eut enu pon pér ela bil soc pub réc her dia liq aff epr pte urn len rép agn chi omi loi ama usi lar reu otr apr égi ére uri seu édi éga réa isé rri rée can pli éné uan vit cit ong ppo ats ves tor bon rel toi cho ima vil mun auc lac uro vre alo eco ala rge ula ein oll cai gou ust ifi upe val rég die its dep gal dat ctu eni rso onf ndu tér nqu sei ête vic ujo jus rra nir epu fau éle iel cas jet vea sée cré ana are har arg ept riv ora abi ach tab eff lig olo och sor tue iff tim iat arm nvi mpa ies adi ttr foi sol vra ffe cip eve sel enf cal mbl iné ssé eta nel osi ade gar gan opp équ fac ono ian anç sso eul ssu pel nit uto ton tel alg plo ché mag sec amm tir uct atr let ote edi aqu dif mmu dro uat log ero ibl idé nue fri nch eus lut ing inf déf oye udi pag mps hom rin fil rév émo org niq vol env nfo rim spe dic cro gro rac trè oss pat dém lib épa ota gén tag rvi oue ira eti urr uil dui bat ber uin uvr fér ham poi ban rdi amp rod dou eva opé ros mor écu fer nér riq abo sin nen urt épo rev amé élé tru lio rêt mou oca rqu emm rog cis sme uli rmé von red ipe uté heu agi but cur hai tar nça ict imi rom doi rmi off ola ich fes nor mér éce odu tée bas cam ram nsa rga ncé oni méd ccu eun gér tré ume arl éli cin nve veu afr çai sto ism rts orc els one lia éve déb sal éal dés hau nfi icu cap mpr som rad pti lqu opo ffr vem uch étr écl yen ude mpt niè cle xpl pec inv dér elq rig ode rio rof sig atu niv gie bel ria fan ome rve cid rde occ tud ogr mma oma dra lin cep ech uen sep nau ars lég iét bea rob bor sag vot gui miè til réf cto sab aro éch ras cre ivr amb ige gio cun hon rib isp nos éte urd ppr rag ouc cco ida duc aud éti gag avi éme eri gna tta vri his jui évo ean hui ext iva dur ipa sib api éfi mba gem cra neu pho olu cem obl bar uvo ajo rle ouj bal pet ane bit pal pit inu pul isc lée miq dév roj rse sis éré nno oqu oje mit dét sul ets las euv lou quo oti nic aci lim hes ffa etr évi mot bri fir llo uiv tua idi ndé ito exi adr lam ada rab tut vir déj nfa asi rté sup rir irm rro cil pie ast sés oui reg net hie una uip ost ruc até loc spa lop vid cié lgé jam épu ibu fam gis clu eng tam éjà éro écr nct réd oût ièm sus fre mus cau hab sco ène erg émi cad mod inq éen fem ual llé bur med hos gre uta mem stè nds nsu rov ueu rto aît tég hef fro sav exe erd uff evr rét nnu séc arq iri ceu",
+	"hr": "ije koj sta nje ost anj pro pre ima jed sti pri cij ako iti rij ran ija ati ovi ist nja lje ani odi pos rad sko ova red ili jen ali nos oji vat nik gra eni edn tra nic ina nov ski sto ovo tsk nij est ana ava ira kom din van elj vje god jel oje ici rav str ika rva lja ada kon nog jet aci ora ats jer hrv voj dan ori ine nji oli mje dje iva nim stv aju ila nih ama lik eno nom enj tak avi ast tav naj gov ara raz oga dno lju ini vij ena ica pod dni vor dru bil eli jem pra rat avn jes ske ren tel ala tan ite ove što sam iji rem kog reb zna nsk iju lij edi men jav ičk tre bit sve ona oja eta vlj obi aln lov sje lji ano eda tar vno una svo adi oda jek amo kol pot pol ema ent ari odn nju vni osl vod kao eti ter tvo oni kak por nas ene tiv već gla tor avl jsk tva ice drž stu sku kup ska eri ita jeg lan bor tal pov spo man živ olj nal rod ris tni rža eko nak las tom val ate jim kih ome odr ilo rug išt ata raj tim bro sni ust rov era jev roj eme ego nat aje svi dob etn ven rom lad iše ans eds bra lit vla jan ore jih var tič lav ela uje agr mog azi tit poz viš iko mil sla osi kov kra čin laz izv rsk iza inu alo tri dov vel ovn prv aja zbo ivo pla eba slo ral ola gre šnj tro adn min dio vin nis elo rim tur tin žav dij avo ječ mat kri dnj tvr kad nci kor oko vim ogo tje nek ere ion nar čki tno bog ret udi rek ave taj đen sno obr kaz lni ele eka aka lic olo ins log enu rni put nač ars isu ekt ese ras ane slu ijs sre isk ono met zag ačk čko ato oče dom eći alj mal bol bio dra nte ura ači međ eđu omo eće ros nut kim ugo mar ogu oto pok rno ern rit žen tog eur šte ale oti ogr nit rop oma zvo ike ože mir nad sad nst ade lno pom ram upa pan oba res ste pad ško vić pis čet pon ješ nap šta obj uči atn ado ame asn vom oka upi raž inj oru tov sli aza ime nam kre obo noj eća mor sud ino tup ivn nič mij dal zap tij uto kar dit ito riv evi orn poč dar vit omi int lat uta uro par oku dat vil dok uju aro dst još ilj tat izi pet ičn nti eva oso naš sob vak rog štv ril mož and ući odu ozn ega nan toj lis rak mis nta sus apa osn ank vaj zat iku svj anc ula vog gan ete mlj den jec isa ede don dsj vot gos emi jun rot tru iro diš jal čen jeć fil arn sva ben vri bli čno kat jud ogl tir žup zak lin odl ozi ner ivi ten otr rič ero upr emo jni ajn tko gle jiv vrt zem pit ned vre upn izn obl ult lič rst oči ćen roš ruč išn one vez edo rez nag tet čit niz pje ant vih ose rin rač jak aže bij ure vid nac kan zan uti kun tol ače ađa cim hov tve ušt ide izm nav apo otp ode isp okr otv čaj joj iho jat kla bav bje bno uni dos opu dil oju dne jam adu eza des riz ođe atr vio čke usp lom iča odo rob zra mer psk tem led gor rađ vol sij pun hva zir lim rne zni bri zaj eki enc žel daj nes tek izb ole ovj are rep ajv pog aut osj čni šen rdi ban pop mla opr rac zin dav eto spr for asi aži dna šti osv ilm ruš ašn azn igr adr iri uze vrš ota reć aga ote ezi seb osa rvi per epo san ipa jez vet kci mno voz ojn kti akt ile ogi jom juč niš itu ude crk zad dsk dvo ošl ruk slj igu art etk dol gij ača urn liz ziv til dog mob rkv reg fra uri sin nda tic eve fin ric ića dva elu zlo opa kul ažn bal imo gur čan imi cen eks ops orm tis vis ami eca len klj ašt maj sim ešt rio ark cio sig obn sel med esn usk zav anu gen zor bja ard isi ubi net sat uli juć eml poj ruž rti rta etu set kva opi ron lag sma luč ebn nem rga pob pli tka azv vra icu neg jno vač luž roi rik čel tio org ets bud zab sna uga ens žno ont akv apr kin kam nir liš izr lož upo guć ojo bar lek ugi msk lar uće uda end dvi eku les njo azl zva uče osp eče zac pak zij ndi spl dlu uko ort uku oiz bis smo ćan ljn lem uka mon ved rip ovu blj edu moć ver isl bez ive rir dre azu žan odg aša dod olu oro plj zas tik rma nce kod ćin žni vao čla mov fes tud vrd zno rvo top ons tne kro kal zal vne ešk iče ton eci ors aca etv ire izg ček dgo nep uži uća skl isn jub atu ubl čak gdj asl čka luk avr udu ntr ago ubo onu otk emu esi zam uma dic jač nev plo užn tvu užb čil sil dug lob eru rag vic ope rao uno oza uge tac dim oci ind lon kve ces oži roz",
+	"hu": "sze egy meg ett ban ele ogy zer ott ben hog nem agy ere int szá len szt nek nak zet let tet ség min köz fel kor ete esz gye ell sza eze gya áll ter tás ész ely ala tal ent hat ság ény tot ány tel het ért leg csa mag olt val ssz mel lle tés ato szo tte eke isz kel ren tek jel kat ind sok end tat nye ese lat alá kez ket rin lye asz eri nte vál ált vol ker lla ése vez nde ték áro atá ami lta már eté ors sen ége hoz vet for zte art elő ert ába unk hel ont rsz nag ber yar lás tak mer rül att enn tta ros nap zon tár vel elm tán tan ame ató szé ond ill zen ata tar mbe mán koz tes áso emb ára éve oly elé szi ásá ehe kül ene ött men sak ten yan ves más les vár zág elt dig zel mén fog ezt gat aki yen öbb est ját lés öve res töb vis zés zám zás lap sem nyi van lam szí tos gyo éle mon kap tör zta zat ege nál sít zak rés ked erü cso den azo ább ébe ták éte ült oka lem nyo ető rté dés azt lis tud gaz ént eti még kép ére els leh ara tik dás kal ésé szü ebb áza két oga eme uta özö lte olg edi lak vég orm ide mil rek gyi utá zot ról rom get lát tén lya éke vag alm elk kén ály öss zem lék rán dik abb eve rte aka oro yel nne apo ála del lan ágo zal sek dta alo ház árt emé biz ika ani ása ztá dol jár rmá gál lko zik szó obb ezé ava ást tok kon ból vas elõ yek eln ort san lni ció kér kés éne lál aro pon ret nté tle por mar ton sor yer tem osz las ori ügy osa ják zül mos ána ély ann záz ajd ölt pol érd tsé alk lma ozz lom ján ver tor sár lít üle kos lli nya erv ünk eli kko nny oss han kar ítá ony vil áli ási nto zol bel hal kis ped elj ken ana ult kke rve sel zab ndo kol tér ako ört pro omá nna etn kai íto gal dal iat zto akk köv mun sik oli íté bba sal ede ván adá rás egé zzá kka ést asá égi ola ize ste lal son szö íte fol erm mik set aló yes met ncs sér ess tja has nis yet ilá ámo ost ran etl éko eny elv iss cse oko zér eni rta ozt ist rto lán any rde alé iga kör dul ang per orá épe edé old eur bor tól arr sol égé ová lel vén bbi lek lna ász mér csi pár nél lgá szn tsz nök lág gon pes emz kba sta par dot ven yik ges apa rok rad zín utó ism ozá ada llá teg úgy ers uró nok lva mét rme ass így har ink zek adó maj pén det tál oln mes hol ozó bes etõ lit sap and rra ily tke hár zöt yez zár ike lje áva zni ysz ugy eng tha hez zél kik nos ati seb önt err net fej nba aga lha lme mze enc all elh mia usz lyi tam von ará áci ssé ezd kra adt kár ési dön vat iva jes tjá ama ado árs ona ord gra dez iko zok tve sát tám kön bbe lső tla zté mat fél ron nyt ise onb llí lke ndt ági zin rep vek etk ror iti mad hét tne szl itá rel ini pat izt toz mit lnö véd föl gyé bál inc egs igy gés tbe kus dsz ajt rsa ajn nti tét enk nev nge apj bal öld iku ráb ről sme öze nka gsz azd ltá sod yom tók rat juk ali ozo rál cím mél tol don fér agá oza gen egt red aza rik dek kbe ező saj yon ssa nká tra eré áto kin job ine sba ssá bet esé ttá zék itt egn aho api róp váb dat tba rgy ila tov haj tté zól got pál lto pít oló zál gén irá kna gys gad olv éri lyo fiz ülö lag sét gok egj lad egf atj maz rre érk orr árm atn baj eki szk tko gos rke etb eml aut eje örö rem őtt ekt ajá ülé the rna idő ant zle kul pcs ágb rak lmi gba gel ram nik mok sko zav mut énz kot gár aká ájá lik lép tni gek rtá fon ern atl nni nal omb yos kne zze dél kes gyü roz éde ing egk dni rma sán aba yil ekk ite vás lda erő lja apc evé ósá ári zor gre pot zda lön árd okk vét lőt reg atk lió tis áró áté ive lyá élt ópa bar fig der áma nyu ezz mol izo vid anu rát alt gít erz mai mek tün üld ula ljá gna lgo mba dom epe ybe elü áts uto rog köt ből ged sse egi edd seg ger ank érf zít lev lyt ega anc ris gge tag zeg uda rfi okb sab nul sül lét gyö pül jog hon múl köl ító árh viz rol trá dem haz mas elf omo ane osí ikk jno nta das ács ets iká kom bev ina zbe hát rse iai dja árn arc óna nia aná óta fia goz orb egí jáb pia rvé des idé olá tre lve esí kod óba adi lté kit cik lka zná lez ldá lin liá tun lós laj amo log káb azá vés nyí nin bol tős isk sai kre tób okr épp től ekb nyá láb ozn ütt oll lkü rdé tna néz egh csö etv lég",
+	"is": "inn ver ing ann sem and nar til nna við ndi ður sta fyr var ein nni num gar ins leg rir yri haf lan erð sam nin ega sin ekk með han nda kki nga lei enn und inu það ast rið ngu rin ist hef tir ram fra eir ess end stu all fur man rði jór þes tur því tta tar aði stj ill ðar egi ngi lag ari tjó ski ðin ban ndu efu þei nir rðu rei ald unn mar gin ara eik kom eri eng eið afa mál seg upp rða dur eim rst fti mil eru eit gir tti gur frá arf men þar rna hei veg ars tin est len efn kur ger eft jar ust lið gre gja for dag una okk rey tað afi æði eig ett ska síð rra nds órn lin jón far ráð átt sti ísl dar ber gna gum nnu aðu nað kar ran son íða din fir ldi lut aða vei ama kin era lög arn lli dir ang eða sér étt jóð sig tal yfi lla ina vin fél tan nns ank hve ynd arð rík kan kka vor lda ans rét ljó mið sku kip ðum rni mun lok mei sla kja egn ste unu stö rum gun nka ttu slu éla tak ita þeg her ætt hlu lum iki iðs ags kku ild iði sto kið gan nsk ræð ark lau org ðan kað mik sso jör þet sle itt kil lar fer skr ors ygg jár ótt mur orð llj myn ldu jál min ðið þjó vel iðu afn nan lað uri kvæ tæk ert sín ðis nið hel mað svo bor fjá álf erk eld sjá jöl íki hún nig rfi öld kis ðir bre hal aga iss tum lit iða irt töð ögu agn reg eil nun agi fjö gði ækk nle str uðu kró rar oru sag llt dan fna hug ssu rón ári lík arl stæ lja aka þin íma rle fin eyt ipt lun dum tek ent tím óna sve ssa ers hjá set amk ðun sko æri sen ála tun gið ile tið fni hin val kun fði hús óla ala aus bar sjó kri tof ind fun ens irr sum kum run kyn uta rka gis vil ris agð ónu æti ali öll urð amt flu hag llu ngs flo væm ule auk rau aup ona lýs ker ggj egl ára ell kna erf ínu ana höf tra ðal gen hen rsk uni vær kem aðs get yrr jan kau jum hva mkv áðu völ rek nst emu ira kju oma öðu emb rsl rtæ err fle arg egu iti jal kos æki jaf æst fan ólk ung rif urs art kra íðu fól rga lur óða vað iku vik væð fær nur jós rri nas stó omi ókn tla sun ley áli ðst rma ðla æðu ðas igu sók erj fre tæð ull onu önn ame sar lán ila glu rað yrs ggi kni ort mis sst tis uga mót nef aun lis rgu urn fnu tni auð van eyr hér bla egt efð ynn tas fst vir ssi ákv fré imi eyn ten fal vík rfs mbe yti nis vís ðhe kul igi kst ign ske els aug skó vör öfu líf aft ýsi boð örn eki amb epp tve geg kon ful ðsk ögr sög ætl nuð rgi ika aví æða etu ána rki ili eta tei þan fið par ini ína mán öku gri eyj læk ost nok arm ryg fja ern dei gli las tel árm áðh ale pur óri dið næs jun kal hæk ðsl tri afl mle ölu sýn nor rla arí dót rku egg dre lug kir kvö fyl anu fræ kól mst irð tök eti urf ofn uma ams mni att gas mor bré réf fnd sæt als örð þau ney sva nes ánu pta hvo veð góð nnt ave kve úar ren aml san svi jög fum les rot óra rli irl erl sit gra dra lta byg dóm rke mæl raf ott fór ldr rmá örg kjö ará vit iri orm óni bjö nýj evr öng ard tór æmt nnl árs hæt uld óðu amn guð ves byr ðil ndr res ani eðl arp lfs yrð eyk sma rfa töl íku ppl ðus taf tíð mör rnu gsi sme eys fis fes fen íkj von trú kas irk try rja hri bro alþ íka tef ask iga gni ilj rse mat pti lít kle sky ken æmd þur gla irn önd öru gle ægt ter mjö fel ský sty nus bíl tig bæt gef bei krá oða aba isi iðj vis lát afð kuð ftu rit anl sdó fla pin egj imm kor óði rát erg klu búi ikl lst gær jas lki ilk sel tna ögn sli nta ork ópu afs laf arh dæm not elg svæ ækj isk lab kýr fim dis ras rkj nss gnu gil jól uda pró mag koð æmi rju tja agt amf óta ást mmt mba asa mín ikn ólf ota tab úsi kla arr akk hon aðr plý yja lky iðl ifa úsu atv lsk ykj nem ðru omu gæt ðri rag fjó lfu smá gam hre hæg mta nei tók ink ísi itu tvi met ylg ben eis ísk séu lfa lls rúa abr úna spu gag bir nah mæt íti hát kke inh gru fðu lgi lir mes aki int öðr óst þær ykk elj óti ugu rve grí oll lds uði þús ath ima rfe sið ugs lsi nsó áði róp naf hál gju nve ofu hor bak rne rfu kks mér lle uve gjö utt slí óse etr gað rúm bra gðu áru ven þát das kef mön ngr sal nal hóp íbú kep áls suð vró alm ýni ngj oft iðt lga lmi url bja lme ðsi ðra dsb mas rsí þór rjá ims aha akl rta ilb sni æja eks srá ton ýja kat ðað nti mer sba rós",
+	"it": "ent del ell con che per ion ato lla one nte zio sta are all men gli est ett tra tto nti pre ere ale att azi pro tat ter ess ali non gio com ono ant lle nto ist ano anc ati chi ann que tor res ita ica era ont tro par oni una tti and str nel ran ari eri ver ori ssi ndo sti ore ata rat ost nta ini son sto tta enz ina qua ico ass ort tan ntr cia lia cor ito ggi art col dal nno ian ame pri ser olo izi ora ani ona ond acc int tte end ome ris ste man ili utt ric nza llo oli rim nch sso rti olt tic nal tre ues tal ren tar por ior tut ici iam ten sul pos sse ria ese cat ire nda nat ven ino amo ers gra ima ndi tiv sco erc rit inc tri ate ott rio esi sen ero der ine ura ità ove dei spe lio alt min cos den cen car ssa fin zza oss lit nde sar ene bil sol ara ola sio rte ide orn mer for vol ltr iat agg ier mar itt uto più iti fer imo ive rso ile iva can rop pol zia rma tit fic mil van ova ert nci cco tin tur ien ime app uni rta cam opo dic ice ede spo edi emp tim sci gen ind igl ave tes ual mat ber imp rov mon dis ial iar mpo nco nzi cer cit uro cas ron ana isc lan ret ons riv ebb rie anz err ern ole giu uel tem alc nni lic lta oro ner ult rna ord ivi far rin nic erv san lli avo erm sia vis reg seg ull nis eci omp sce cal ros gna ors raz bbe rsi rri ele reb bia ich izz nsi amp arr eco azz occ oma vit ava gia fat lor ard unt sca sim rno eva ens dia leg ivo ces han dat sem qui osi abi egl pia rch ila dir rto lar nce rea pen nit esc isi vor cio rar hia ena pet nos mpi via isp lin mpr orm dop imi cin ppo oca eur vin rdi asc cci ega iss lat cca let rre uan eno sit lti mes oll iet sin fra egg ifi dov esa omi pas opr cre arl agi sal bre inv aff ast cri ral mag pot arc agl met rom due din hie rca amb gno ezz uta eni mig egn ane rag nar nes ing ttu ope mun cap tel val iso sid uti ram uov suo rem lav egi mbr liz ote lto pal ala emb ibi rad nuo amm vat pie omu abb adi div emi ogn uno eve don ovi lie tag tam ffi ece pun asi rec mol bbi pag mpa onf mbi ida len opp mic oci des cch spi ite inf eme uar sic ami sec orr ved sor set mor sch cce osa bra dif adr fon ras gar ecc rig nze esp ben dar rog not bli itu ogg pon omm ure gua ume nqu rra deg oto agn det rvi emo dio oti mma niz cur soc osc nve onc las ubb naz ogl roc fes poi ema evi gni aga rla nna red ger bat nut ete mme mis bbl ioc dit ace nor nov ché zie lis aut avv ins ota uzi ius ton ffe rav dan ade ill ama erà dec nan iut tua spa cis rif aro sat les lme dom usa pio enn rac ust nom sua sig cel pub sis igi mal gat rev ucc ezi udi cui arà tol nse pit lte enu mmi tec omo iun usc nia iri var ars ies zat api bas tru viz eli gin rco gge nsa ban rmi eta inu mod rni taz nca zzo ife etr tir gol lib apo vic rip erg lus off iov ira alm nne med mas lon rci bit laz gan aco rol bar egu pra rdo cip rsa tos rap ovo une odo rda gue nge dim vev log pat mai evo fac uri rob gre dra ung già rme usi asa nie cun arm ipa rot nso ssu mpe alo osp erd mos uol nei rro oco ngo uad ela dev pes edì sot ttr osì gui tiz vid tac zzi ape icc rga fro sos atu ang upp ogr loc org lem oce lcu gaz vve eti rir gon rez cie cop ppa lam vec scr rse rib lim sib isa aes tie cir poc lun avi fil squ diz vre idi til fir erl scu gov iff vel cla bel ler ril ose eso mpl ada neg dur clu iaz rof tav nio ves eal uit vio tis ppr rod ied upe onn otr teg bri età pae mia ndr ppi atr rel sab nfe ccu lut ute ffr siv isu rlo sie olu ude get cid fri bor aci aio ago ign die fan dag rve egr odi age uin fid cro cil tia ced imm icu net rep mot ibe ntu ase pan vil sog cup sun dre uat coo erò ego ppe stu asp lui ook rtu ovr eat ism pic zze rid ipo nun iro bin pur iud iac lci tad aiu ffa aso sup dav obi vie ieg irc iol pin lea può orz dai ghi rai ble ado pli smo raf rne mit uor oso noi hio fam voc nea paz omb tas gal unc dut pis uss nvi dac dot bal avr hiu uff apr ode rut her ipe bol vvi nfi vot ios aus sil pec uon uom uis gam maz bie sop arg cad igu sap pac olp pir rge nam edo fet unq och gis ses mba riz onv zar fuo lot rce ttà ple rup tif nol dol tio cus lev fre bis",
+	"lt": "ini iau usi ali aus tai kai tin ien iai pas ijo pri sta jos gal tik ais ink kad ant iet uri vie oje lai mas kur etu lie ina uvo asi čia tas dar ius ent aug eik aip pra met iki ist int rin min vai ima oja avo aik imo nti vis sav rie išk lin cij dži sti ama ria pat nin kas eli val rei ies eri tar nuo inė per ija lia tuv art ios uot iek tur kia uos toj kar par die tei pro iam nes ras ika nės kla men var and ing eni buv kit ino ori tra jau arb sia tie rti oli tis sto kal ven kin lau yra mon oki nia mok lio iti sak din ris sio avi nas ose auk ats nių yti ari nau nis aut oti dau api kel man isi ame ran ėjo gia adi ska nka ena eis mis asa iko est ren omi žia nim ite pir ati gyv jam ter ili tos kom nio tat gin pie ver vos nta uoj ami sau aci iuo end mai vei auj aty sus kti uli žin ast net ams rau dėl aud kos oni riu irt nam esi tor ala liu ome rad kon aba nus ste ita bai lis nor iem ait ekt yve nči ank lan čio sis tus oma kie irm tuo nai sit ači čių mos pre rai ruo lik uti sie eta eti dal eno vyk rij kra ara ada žmo būt enk eig ias did ojo rim imi rio ato nki niu imu str jai sij tok osi kus eči eng ieš aul rod čiu aly vir ugi lim ski ėti ger bet ybė kam raš lių kim jus iči pag nos tam yje ava anč ona ana ano era atv amo sir ros ntr pav ova aid rta pal mie nep ndi ald ata ate ert lei kri ide ele joj vil oto jas gos tro ain ime ici imą etų nga ngi ion ben ian dam sim uom ers ėja bus mus dos jim alb aži žio nei lab ner gai vas del šal rit kst nto maž rus dra ust aun ikt duo iri uto aro vad mat ten ški tau ang aka pad den ani ėje kir ono ikr gra kio nie imt ask vim ndr omo rat ard ška iva tel bos tom tad ioj igi ems uro isa sty jie lit tač šia onė are eto ado esn kau aig eid sin ikė dėj tsi eko epa jis akt ako ram bal siu tyt dir ary cia tvi kyt alė kta rma gim sku ida als pol ito pak stu uma bės tri tre rto aga sni mes yri kan tru nal dav aim vyr pin voj lės rba dai rių ote oky tan tyb jon iją muo isk alt bar emo lyg oji eks paž por ota tim len ban spa ikl itu uja pus san jei dim nom rik žiu ial ine rek sid dov sla bin pla sva tek urė nda jan kto neb pos pan kli idž ost kre gas tės rga aps oks gau yto inu dyt yta ung gan eur las air mer nko juo cen kol bei van rtu das uni sen eim eda ort eka nij uol odė eit arp ane eki ngt ind sut ybo bil enį ark tūr iku ūti usk for ryt spe umo nkt erg ket iej atr nan alo iln tal log mot nte oju dom adė sių enė orm lni spr ega tij ene tys bia ykl šio udo sve nku lst lap atl suk ale aiš ern esa sik vin gus idi imų gri ral kov idė uod res iks nar kių olo nėj ult lti ira ngo ieč gru kok kat iui ksl ėli eši one kšt ema ipė eną išs ėji ėtų uvi gar ldy roj koj ere edi ijų irb oka uta pil pėd šve tyv pen udi rez dvi ovo suo dan ogi kuo ins med yki sme šin kiu neg inę ybi uga ųjų kvi pap rna lij gti esu ūsų mog roc rop žai uki kėj kci šta abi šim ėju dėt klo rėj sek egi ukt obi igo rov los gel isu rbu sar ūro įsi dab ško cin sči tap spo vid aki ero evi ntu via jun opo urt tov gij rbi unk ndo rgi ymo idu rsi tol nat emi dis alv ido pam myb dij ans gam šti ajo iru mės doj oda vak ars ors rėt nel der yva asm odo kei uka amu mob ūna nty iga vus lta elė inį jog gre noj mpi nci rbo mar vės ber ute užs fin avy ryb elb oms itė kis šiu įst bėj dyb mia iav vau lig žiū lyj iūr igu iju lyv gum tyn rem ire lėj sas tod aky raj mūs uva kil ašt kul šči ėme ens ėdo lek ete ėmi elf rog teb lfi ovė eži dym pel pti apl lėt ovi ora sil ugo oci sud ske aur tum ism reg ažn kyk sos ūri ėsi tva ojų ūtų rmi iso lic yvi sko gen vič ukš akė sum tem šei ivi ėra eat pač uda vėl uzi tyr oga tli kūr mal ola emp zij sun pau mir jek agr vių ygi neš yks pli sli nde nkl irk iza ume ity gir sul rtą kty mui dro ive ikš iky ogr lbė nks rdi rės mti kėt žsi ūks ont imy rup ipa enu sup nem alu odž išv psi pab rmu niz eiš sky etr sig alg bli rys inf apr rac lat yst tėj tes org dėm ilg nėr uku rda nst ole ngu rst lgi nev sip ėse nyb vok aru izi tsa lem reč ikų įva tit tym rak tyk klu ody amb nkų nut itų nig moj tak sur ret ktu ntų orė lus aič uči tve oro",
+	"lv": "iem ies ija tie ien pie vie par iek jas nie lie ība inā šan arī iet lai tik kas jum ska tas jau dar bas var vai kur gad val vis rie cij tāj ied umu die ika stā pār sta aud ist ana oti pro nas kai āju lat arb viņ dzī ais iec ent isk ums str atv kum aut iel līd sav not als ēja sti lst īdz pat ras tra tur man vij bij bet nes stī pil pas tvi āja paš edz spē evi ajā vēl rād aks iju nāt pri iņa gan āci ību ieš zin vei nav ina kst aik jie stu tei kat dzi ijā kon ena eik mēr iku pēc est rau ris tās aun uma ama ast las vēr sko zīv ada lab sie ikt udz ini vad kār ald usi būt adī nis iev ārt dīt ekt ēju umi īju kol pēj jam iep oša aid kād ter ieg ier eci dom sts aiz pir tād tīb aug mie cen uši mum ādā tis lik ici esa ils oli tam pre air trā nāk āka āku rēt maz min īgi āda mas nāj ara bal alī cīb rei jās dzē cil dau ldī vas oju ņēm avu nos āji iks nās esp ilv rāk īga eks ens aga auk ali īvo kri ram sāk cie nev otā tor kam men izs ībā anu lvē jām nek irm umā ēmu juš red rot aka brī cit sai mes atr anā vēk eiz des tīt das kar nov gal ant rak res atī mat rīg itā sar nod eri āti ava roj kom sas tāt dīb ene ņem ati ārs mak eid arē ērn lis zie ītā iro pal cin ecī jus ere dīj oja kop ētu enā tad mai ētā ēta etu kal dro aja kus kie eta bil ata gas bie irā būs nep ekš atb akt ikā ams isi onā abi kti īst iņš bēr eti rst orm ild kad rad uzņ zem ves eko pol īti ēji eku itu eki ste era dze gai ain šie tru int izv asa nte att māj sam sau ota omā adu ats for per zņē and āli zēt les mus jis eir iņu tos rij ēša enu eva tri tīv eka taj pra oda eno jot vid tūr tot lau izm īja nāl uri dus edr ion ten iti sto ekl nai dot sēt elā sni ete izd tes atu ādi aps ret ērt māc iņi nor gri eni dev aim dēj klā iāl ola sak ome ort art ējā ers ādu uzs vot tāv sli īgā eli run ori ziņ atk drī ūtu rīb āša tus zma pag epā den aču por rti eic kot lit ekā unā tač ktu apr bra tar aus ide ski tai vec ova āks are vēt dal rba uni ējo tom cer epi esm ālā rit ura lāk jai ani šaj mūs ele utā ven skā rin tāp tēj gum ece kau noz roš imn mni omi ļot ona inf ēlē eši sma oši uto kto pāj vaj ult eja ēti nti dēļ lēt ido alv spo oma rīt enī eda ned ans aji ksā ita ela ote tuv ner līt tīj ņas uku āpē māk egu nīb auc lēm rta āta eša adi pus aba īpa avi arā āla etā kli lsē ārd mēs ami eso ese ādī svē rai lic īgu āko ntr kla ino ado ēki tni ugu āst ciā eme izē vār abā rma ukt tāl ūsu ait spe rmā idr zsk rēj tir rob isa ust nfo āri tau esi daž ūra ceļ apm div zīm dok aru azi jek nām uša daļ tīs nīg isu ema tav tro šķi ert rat kļu ari ļau adz neb ājā una kās gul din rbi ešu dāt son īta rod nom kci zīb nij ntu rez ses avā net pam bin nta ato rtē emb rīd epa lin zst nam emē mil mbr tāk nāš mek rāt bez ātu omē tām gar klē līb mis gra jon ozī sot šva mēj aul tel toš met cis ēdē dra āts paz olo lās emt otr sim kāp sap urs āds ons gat nau irs ašv eto epr onk sīb rni tum tbi sla āki dis ism gus izr rop šas sab oto smi ājs zes bri bau zēj kta okļ oje nts dēt ēra saņ kaj irk ost grā nce emo auj aši oga gād odo āni pav rbī bīb zīg irg nei san oni rīk ārv pak sij āde nda plā lde der spi rso šād pla lān tij gāj kul bai gav nea imi ktī izg smu asī ēst ugs līg ros nīc vēs ēma nku lāt fin atz arp ņie rtī kan lim kra ime tēt orā ākā aļa tin ver sin jos ātā ekļ rms rib pul tag ādē izp urp zde ran lek oce ate āma rga rbu ēts ban amā uru sal aro rek pad urē ala jad kts nto sev ezi etr ogr ksa ēro aic tre sku evē sva ces lga ēku šam īgs dāv īša ajo tīg izt ank slē bei ebi trī iln urā edo edā ots sit vir rog ask ivi ukā opa tne sis mit olē rus aci rim evu ojā sat rva uda āte adā izi prā dos sme tuā āno ins ieņ ird ācī tic ksm ašu nan ono sac obr sad zva mam tba ātr nst odu ģis eit šin krā gst ore ojo ite dri dām zvē īts esī atl ren ero edi alt mos ano miņ voj ont udi elt put roc dza inī idā mīg sag bli rām vēj nol rnu ažā sek tēm ars iņo ica stē kša raž aņe pst alo zve oci sēd tti eģi ale atg dam kos ard āvā ētk gāk asā āpa ora kuš īko rdz lve ģim ilj reģ doš und bāk īvi cik lēj māt tka cīg ldi bām obl avo zgl tdi tal",
+	"nb": "for det ter til ing tte ste ere ene nge ett som den der kke ten lle men ikk har nne sen ver med gen and ler lig nde ner ren han ger ist mme ent est var ske inn opp lan ser ang ier ska ker lit dag ell man ert mer sta enn nen end fra ans ret ens ill ort rin len rte nte tet att ann ove ers ors dre all str one ede nin ide sie bli ble jon nor und els sjo res ord kom kan art per eri før tor tre ken rer omm jen ige vil oli nes net eng ete iti sto tid lse del kal vær itt age sse het are ran isk seg lag ise nsk ale gje eld hel ern pol tal unn vis kje ngs lde nse rik sam pen rne kte ber kon jør øre ele sti ikt set lik rge sel ven eli jeg ant ndr fot org old ore rst mot asj let red lin tra mel bar tro tat min pro vel get jer ære kri ake rde gge ate rsk gan ass dde alt ved eve oto ogs dig sin ris lge gså ive amm akt elt ite mar dis rke ket spi hun par vin ekt bil noe ike sis pet kla tie hol iet les utt son ørs leg lar lli ons ess ien mil run tel ine led ppe tri fle ått pre fre van rti gre ute reg skj tin bru oen sik pla pil erg sje tis kel sat met jor tar ært rek tan eks erd erk las vor sid føl bes egg tig ull kam amp vik sli kre val lis nrk ese hen skr lir nal kap mpe ade eie elv sak fin kti nda nst eid add dri jel sla ets ege ane rem ytt nke kjø ron ros lte gjø rbe god hje fik rli bak sva tem ned dli ruk had fte her rep ind bra gru kun spe sit ølg rie ram sku akk nta igh uke rit åde bei ghe idl ire ark ted arn bor tur ekk int sda orm tik rso ras emm ali mes raf era sko arb nis mål søk use riv ykk vet tak pri fol åre rre ast ndt oll vei bla jem tes lev ars hus nns hvo ndi rme rda ont mid fer ard ffe bbe dra enk ile ode erf mis ina kul nom går ldi ari syk nds tad gra stå por ion ole stø eme ein rat ses kol dal ins tiv tør dan vid esk lom ntr oss rse slo sve råd ild bet mun ilt ban dle san ost ose alg orb din uli kts når nyh ski yhe høy eta ape ves ori rts får olk ame sle kro lem esi rdi dem rol tyr iss ili ørt rel ben edr ung ong des kni rig lys ati sek yre syn llo lta fen obb rak kes lut ygg rei rfo ppl err gel rna gne oms løp sol lsk erl øke ham far dom ald ank ilb liv mor nno kor erm slu une ngt rom nat ika ela mmu aks lke app kse ror bal fri rev hve tse tsn sty pos sny kvi ona mye ini ndl kra mat eni øst spo tni nel dat osl møt kve nye top nyt uss elg ryk nær irk byg erv ara tsa ils kad ats job beg lok tek ifø fun eda avi ngr ian kar åpe ime ges gjo hov lat rsd rus tru ust lie edi øpe yst aft agt åle lba hva ama kin fly tts kst alv vol sei ldt øns nsa tta tli hvi fal igg uni tje klu hal uts mas kat tår lov mul fjo vit pas ave øte ubl rea ask ani ami måt gle bed tvi edd ift orh egn ksj spr rap aml øtt lio jan ldr nfo okk idi off lei ids nas ref meg gst tim tas nli spa ønd egj pub lla lad trø tfo ful efo yke sky båd ult oka tok rop fir sør fel fan lyk eis rad fil smi fåt dte nar dni ana øye åri røm ssa bre sni ork lve iel nok igj ond løs ott ssi ral kli mle rob gam epe avn anl bel nni ønn ote eha sem emp pte rve øve arl rma avs try jul bud nga vår iks kil esp adi egi rsø emi vir beh stu utv kle amt rra ora gik mbe sna yne kur øde gar lek keh tol aff isj upp pes ple lør evi små lub sun eil odt rsv vne aug hei eso rud søn omr nti ure tia jed tst orl can obl tap ubb jef svi lst lær ita omi fyl slå veg idd rav bek rsi kas rri mrå arr tba ept che tti kva åte tei ukt our mst die ivi nut rod ema rsl fes eba fje imi iva lia død eti rta asi nsi ppd eds ton sst yse lje rga rmi vde rla yde fem nle nkt sme eir itu als pel ehu the orn rup sma urd dir bri rid års log fis rho jek udd ria osi joh elp mti ala hav mon rog prø ope sca ykt dse esu mus ial erh ign vak nla gis emt sig økt nak yen rim emb tne orr ipp sas kev klo eva onk ola bjø ørd hør uel hat usa ntb ags vok ude niv inu eke ntl ilm ppo erp øyr rle isa ekr rut emo rif ule lgt pis kto vek bev fat gla unk otb nit bol adr spø ply ømm idr sia røn bør nna dsk tip afi uro egy atu rhe anp npi ato ida ikr avg fei pix nnl isi enf edt irs sco nsd usi rio åne urn ega stj ørn olo tab åpn poe håp eft pør",
+	"nl": "van een het aar ver oor nde gen der den ing ten ste aan ter and ers eer voo cht sch erd dat ond ren ere nie ijk ijn ken tie lij rde men nge zij ens ent die maa iet met uit end est ord lan ede lle eli rij len ove sta ele aat gel wer eld ege ven nen eel mee erk bij eve eke dag ijd ach nte ind wee als ige ang ati naa ich nne sen hee eid wor ger ant eri ete tel doo ook ate ien pen ker erl eef pro hte oen art tij hij laa aal daa waa ert all eme eft che gro del rin eur ist ber raa vol ame ier str eze ite sse lin moe oet gev taa ech uur mer euw rst oud tra rie kom dan ome sti bes ard ieu ben ran lee man kel per ale lie ont ari roe age ges bel are isc nse erg ron iti wel kan aak oek nog bli cha tte rge hei geb ans was kke ide jaa gaa toe gin lde ope heb nst ein oed dig ort gee jke rec min uwe chi ene ies ake res haa tre iek ude twe hoo din wij rda gem zen vee nda zic ast nis ree ouw con ats bbe rui wil zie wat era kun par tot pla int teg eni dit sla oge ijf olg org lei han rke akt ong voe ell erv hou dri ela lit nin elf ote ebb uis rdt spe nds ees nke roo lge tig tee ali doe ema rla tin zel aag stu erw zoe tal pre och ine tro oer val ngs rti hui ill sto lic eek cti ern ank kin els gew dez ins oli vin dee ndi tst bru eed war enk zon reg erh vri one hie esc win bet rte dde lev nee spr act sie ect edi ner oon lig cho had ini uss erm hel inn ons mar ade ned gra goe lat her pel ili leg cen rli ied rei jde bra tan rac eno unn rot ebr tuu weg loo nti rek red iss ris uro erz woo ina bed hoe mis pol kte gez rig erb orm hun ets tat edr tri ewe mst ijs ser ban ion ass ure lli pri mil oeg rsc zeg rou eit mme gge won evo ssi tje rel ler ppe ori nta sin gep ann ief rat sel zou oot chr lag ijv eig ezi tse bee nat kri its noo nig jar ust lis ena ink elo nal ost mij tei bre tus bro wen idd nem app dra ero kaa ged bur lem the elk mak dui oel oep com tor oni rop ubl von pub ntr mel elt oll ooi zet gan ijg vie ams kee ore kla aro aam emb ani tge vor itt uid ald por egi beg ric kwa mid rna oop bin enl eva alt eng rme tis led mbe ice tem ett baa bar net urg rug ona oog ral lop wet bek sda rom har ewo uik boe ode ult eds vro lui rma ble dam ese rle spo iel ton dus nam hal hap rkt ple hti las ark les zit erp ana bou jve eru oms ors ike geh nze rbe esl tio mat ekt egt vel zin nel ars wan ntw nsd gek unt rga ess sit rik rus hem igi afg ffe kon omm jes epe rea bez err eil vra zal cee rag esp epu aut oos ora gri dse hri ood bie rad roc mog rak pra eet dst fge ire don sam aas rit rva rzo itg bew eda med uni amp gde oof nni loe tek enn for jon arm rdi anc akk dre fra opt adi avo rob zaa kle ekk zor jan opg idi bal eem igd eko gis toc oes ute nom ezo iev nli vaa tru tar oce ram eta enb kra pas kam omt ika eco rne emi uws ise rre fin oei nci ara rvo slo uto evi pge rok get hen aad ive egg off fer rlo cte ije sne ool eho ilj out teu onz etr ijz hil tad log nts rwi plo uch ebo wie fde paa ebe enh mon dac rbi ole zat kij rhe sle lec bui omd dru air twi hol elg atu pee onn ull ega nve tba pte obe top oto col bev orb beh lot ita hts orz kor noe hon mda rki vas lta beu koo nwe eeg cie spa bri gst uar jge jft amm cia wam zwa rol chu vla uri anu bla sul tur jks epa vij rzi wac rha kba rwe jkt tic boo rse lke ids vis rko nce pan uim kop nbe ept igh rwa isi esu ece son ica nla too sis oem ane eks ild iep ghe joe ots sli woe urt enw luc kre ize kie arn eha slu hul fen lti nsc lek omi tho zak pun ofd ikt oal odi urs gie bon ikk tit leu urd tui iem arb ruk dem eis ial iez ave moo fil omp zek arl orl oit mmi pos lar lad rso okk nov mpe ngr raf cri ljo fri zee tes geg egr lla inc rum rod lac rov old clu opp dio ami gre eeu sma jda pec cat isa blo ain uiz pak nad onc emo nac mde dia wed env eti rtr hin egd los sat nko dec nes lgi des wes rmi let bas mie lim ves ici ogr our dic olo mei olk ssa cor sme rdo dis esi hed sco zoa ono fie oom ijl oss see mag aga sur ndt rab hor rem bov uld sbe rog sna lka oma zig kst lez igt zui und rve udi arc tik emd één asi jze elu ndh sve tiv som vre",
+	"pl": "nie dzi rze prz ego owa ani wie sta nia ych kie ski eni się rzy zie czy est owi iej cze ier pol mie cie pro czn pra wan jes pod ści ach ost szy sze pow ent któ tór acj str ale iał owe nyc nik iem pie owy dni ien jak zen iec trz wia ali cji odz raw kon now arz nic ied iel awi icz ami ich zys cza yst zna ols row ają rac kow ała ter spo tak lsk cho ieg ska tow naj tyc ośc ecz esz tra acz dow rod wsz ist raz jed wni roz oni cen ycz zia szc tor tan bie sza gra ona cja zcz aln sto neg edn zas ies edz ran adz art ocz tar kom wał oli sie any era nym pre war nej był uje mia lic zed wsk ędz ada ora ole dla tni zes stw jąc rad wyc eci rok ane ejs men oda ros ywa poz tem roc ast obi wię oku będ ini spr zni wal zec kar asz orz min ków osz zyc tym erw ony nta sty ows por ina moż ńsk zym cia aki pos odn kol lat niu zac zos rez zne świ zez tro rów tyl woj ana teg szk kra usz zeg ele owo ste ało ion pis tów iad ata ron ano omi noś pom oce hod ięc tał bra pot ują mar zon nal ika for uro bar nas nad taw rdz oje res nio óry eli ech ard mow awa dan par kic rob zny wej zap one cha mów erz ość ate kim aty omo mac nac orm zan ekt sow ały sam zaw óre ato zej dob tur den emi obr ian and kan bli twa ome god wać edy inn dze two arc odo wic aro zam kaz ład rzą śni oże sko ący dar yci lit sob zek rat zyn zło pad jsk rem ars tki nis pon udz iek uch kac ako wym ara gło iew yni tyk lne rza wią lni ili kcj stk raj oko wys ram acy akt wod bez łow mat tal yjn zar rop rak lko wła ańs ymi kor cki ere oka ząd dna zwi ono ięk mni osó kto opo dzo czo lny nar tac nan eur enc kre poc ówn pan spó tru już dom isk tyw oro lan ola ewi och ans osi zał nak zal wad sła ero lub oto mis ort iet weg nte ten czą swo esi cje wid zak oty tat pół sób ytu zyk wyb aby łos eka ęci czę moc lac chc adi rma zył ysz aci jeg ące cyj szt zaj jny pop ałe yka ogr inf eks iat unk ylk tko rsz lis arn zeb ery cią ity ust waż ren zka ucz oby amy uni otr ura ówi lej ank liw wol yna bud kła dro wsp ame sce rws ark yli own udn zyd bro ont rek zow enn amo gro zer aje zem dos oso opi tel log tek lek ywn pok atr nfo iąz rsk ńst iez obo wyk lin ksz ncj twi ezy nni ież też ers emy tka dcz ado ców ory rog daj maj agr pla zko oba poł yła obe win atn man odp tys ław ern icy wcz naw int alo jsz zyt dno zmi słu gan olo gru opa nku per rty ega awe ług nny mer yło wis ant ema ari atu ama yma ozy yda yta eśl tam akc oln kur ewn odk zyw bor rci mor zew cję awo opr ias tej liz sku ryt iła ras atk ycj wyn jne stę lem spe anc dzy ala ewa dza eze len emu iow dał ela cow yde wyd ope oma śli ryc ząc nki stu eck gdy być rud ner gie jej wyp cej jsc dłu dyn eza tęp ser mog elk dal ntr rni lon kam ene aka dio api mię okr aza ote sia lec zin asi dru jan rcz ześ icj życ ety awn ral dat odu kry bow odc wil lep ywi fin ońc reg etn isz mus rto dem der ubl osł nał raf gos iza leg ęks oru kty ode yty ozw daw zro awd tre lud kil lik eko zyj dia ecy nat ryw lno zab rol adn udo yle ria rej yko zwa ysk cał oci dec dod eta ził usi pew eri apo epr lar eda sią ygo uwa ądz low ilk fer iar akż kże wes zpi rom ynk isa nim adk asa ert ore ice nam zag cio ady kat ors wił koń zda woś uto bec pła gor siê ews ita czu iać nię eśn nne taj zat dop szo gen ięt ons ody rne łac zyć ogł lka łem ejn rea yzn ury rzu iko afi aco osk ios sji iac lki rka nto ade wor spa zyl szu roj onk cyc wny niż łów jon szł apr aut ikó jac awy mon ajw lew how imi ena sch mał zuj wno zel tom ińs mal kal ary dne are rwa osp tua órz łod owc ożn rga dzą dot niz nst omu aga śmy tec ktu ede red nap otk nii ugi iom ing cin ęce iep dst rot wyg uka pań iny eńs zyz erd fra ori nty zyp zep urz ybo kos yły rus ezp otn oło żna iąg let ile ląd wne kup leż emo kla abi ięd dpo bia ogu lam ods reś pub nos iwa wst iaj egi ban zeń ędą umi nce ukr eds uży peł nda fil aku ial zio bac nka śro run ozn iki ypa wet ńcz ntu org ide ryn orc ryk kuj łni ową aws odb sło rug lsc obl ici mil każ szą ełn zki rii wyr eba jem jal wyj ozp ogi dów dny wat dyc kań ień nów zwy nią cel noc guj żni esp ciu óra",
+	"pt": "ent que nte com ado par est ara con res ção men sta nto dos ida pre açã tra ant ndo por ica cia ada pro dad ess ade ria des and eir ais nta ont ist uma das ter ito sso rio ora ram tos ser nos era não ran ver nci ntr ele ela ões ame mai end ira for tar tad ita rec ura per ass ime tem ido ras ali pel ina ano tro tes str ico ssa eit tiv nda são mos qua art nde dor tor car cio sen ort ári min tam sse sti ece rad ici ona iro ste ten eri mas uni egu dia emp iza cor omo esp ind ome nal iss ros man pos ore ons gra cas bra mar nha der çõe fic ion tic foi qui seg eve int ese lic ere ias elo eci cad rma fei inh mpr pri nic den ens lho ati tur esc tas und ndi dis nas vid ios sem tan orm cid liz nti ava rea mun tre amb rti ret nça ato ide ari rta are ode lei ern cer omp ren reg rte oss aci obr ênc ssi tal col pes ost enc sid eto mes cam ven ove iva ema ima mpo ori cri raç tin ena ili edi oca lar efe pod ata nad can açõ anç rim spe ana ial tod ate uit ula rna sto tim fer ini eta mil ama odo ual aco esa aba gun ois rre rar pas rat cen imp ipa orr cre nho cul ral alh nov out vel tri tão erá ref ilh seu sua inc ert ian lta pol cip lha emo rei mei ces sco mbé ive bém pen ega cos ond amo uto lid gar itu uan sil ivo hor mui nco anh ior ber erc vis bal apr utr age stá íci mer pon lia tid rem mor val dic dem nst tou içã spo asi vol bre dir rev até sas egi bli did nes ult nce cur isa sos anc ses equ atr ova dep esi ede ain lan ord faz emb cal rab gen nse ete rin ins ale ris atu alt sob les smo ala ing erv err ers elh aze gad inf imo sit aqu rno tua eti taç las zad ire pal oci loc arc che poi abe dev oje tir mpa ulo rão cha ani arr óri los rod san ust ola soa eco lme fin mpl eal rep roc nis amp red uas nid exp ota aca ric ane apa sar ino rro omi alm evi esm dei rel oli ifi uer ami rto olí ite rca uta via sad rso orn pan lis bri ien sal zer ves gov rou ast stã bil tud uar nhe erm ape enh cis tór ivi iad caç gos leg eja ião alg fun oto pra laç rra soc iga mad del rov hos pla bro fes sin gui eno púb nfo úbl rit ago let jet ero rop rde ard gue gem gua lhe mat mbr tec enç aio vem mpe oas íti isc nsi lad olo sim irm pec onc fal dar vei rdo dec asa rce nve gan éri ovo sca rid erd onf sol nsa ban uda dua lem rqu vam ger mel afi ped oco dio div ceb fir aos ecu pró dan iar ans aga ume eis lin uro lev rda eus pli eio lgu eme oda cla fil mis sul rig opo clu cou unc tit udo eli ocu alo onh usa odu ego tav tár cei met rmo rav apo urs cim ile íve nca fre ave vai mul nat ole zaç vas lor iam vez ine pio rog rom édi edu maç lit env tru uem har olv nar bar lti rib ace ues dur aut ext ças oma epo uin imi inv xim igo rof aso ham aul ogo nor sor lim eva ixa sec ibu unt ene nei etr gor rvi cin bai iti uti gre dat cap ogr nan ebe eda med sab ará diz oga bem had sic jog var egr sis erã uçã rai alé dif aís agr ssã vad def ase nom osi lém ecr ple vos nac rtu mou roj cie fra heg ron áve abi inu avi lto eia rci exe log lat uis pau van gas dit voc arg ovi ofe nvo dim olh pul doi hec edo içõ icí squ uca paí bas ípi eix put oce nec hõe nqu mic her gur ulh stu iai isp pai abr sou sam cab ote hei pet one len sej cel gia son mon mit rot gis cíp exi nai lam tel cat lhõ til ope adi olt rob jun ofi zar rie onv isã pós emi set utu sup bor uel rmi lít ead últ bat sio fam ipe iaç ono mot reu tat aro íli neg osa rça din jor oni aix fis omu scr bel rup erg oso eça deu lve uip uir rme air hoj not abo jus eni upo méd nçã pit hav paç bol pac nam ngo ife mem hom rga esq jan rac ssu dam spa eze fed tei ôni ços dut orq sus rês trê upe aúd pou saú adu pag úde ect nár jud tom sce apó cai orç cus óxi ben nio aça efi bus org iri lvi ncl nun ach tig sár riz róx ble vit ibi eso ree mod epa vim raz ang hum dro sci pop iço giã ceu gum uaç sel esu uga ize ong adr dom olu opu scu áti uid pei tev vil gio mba niz líc tac teg ltu aju tér ner cit tis mbi cum sum eço aus diç cho pat aço rgi ice acr meç nsu udi has ced nim omb ocê nfi etá viv nem isi viç ism ânc mal lig zem our gru nen liv epr alv igi rri arm cup",
+	"ro": "are ent est ntr din ate tat ste car ele rea tru lui int con lor pre tul eri tre pen tor ile sta ace ulu pri uri mai ati pro nte ori ita rul ost ari ani ici ter rat ist men tra ere ale par ine sti nta art ata ilo ara cat ril ica tea str oar rii ast ces mar ali fos chi eni ect ion tar ona ina mul res rec uni per ant tur tel ili nal ult min rie nic edi era cur cel com rim tin ini nul rin une ons iar dec man nat aţi ier and rma esc anu lit imp nti loc iti cea tic ame tri tim ind rit tii eci rom unt dat iza ite tiv atu tan cul rti inc scu ato ura cut fac cum lar iei ala cer rte cal imi ran bil rez cti ire tie lic act vor oli ont mat col nea put iun lul nst des elo iul por eze ort ene ins ice cia sun dar pun ora ric ima ide cre ana asi ria tit spe rop uro ial nii spu ane der fer can iil oru lat nit nce leg ute eaz înt fic ner eas mân ven unc ian ime for tia ten toa sit itu tal vin alt asa aca eta ocu ase oat ată ver inu oca ers nci ecu nde gra orm eur nia dic zat acu rep ţii ndu mil cla ond eme stu rad ntu dup tot ivi ren ări mit şti nis abi aru ern oan iat înc cto lte ioa ive ând pus pol eru dac duc ave una che pec ede num ean esp rel ţie uta ndi reb mun ece riv mer lin pla ope ete ctu ome urm ris reg cit pot vit cri bri alu oma lei med ral ban mis dis eca ude nda eva lan ans anc rta cor red ech aut nar sau ito cen pan uti ipa ebu egi rei bui pat rar olo iţi lun cin den ept sca gat omi gur nor atr omp uto aju fin uce liz apt ama ume aza ese ure cep ore fie nie gen ciu mic mpl one ser asc ada apr tio bun sin omâ und sul bli ază eti dep ing nţi fel cop lie val enţ upa tem unu nsi iec jud lia spr pli luc mbr adi sar rem erm apa ula iri erc dia ţia cam spo cas ucr olu emi ifi eşt ret izi vic ală ram uie ecl elu rac rob uma lti ust eve ord sem cei arc opi dou tua ită zen ică pul rio ber dre zil tro eli ger oie til stă poa ezi aco oas ola bat nca mel lta erv sup ţio oni ico iva cip epu ang sco dul esi ela ole nsa zar ert nim etr noi rca cţi sur hip oti isi soa âni reu uat lec ofe nil iin icu las ien oci dus ăto urs iga nel ami mon uit pta ubl ţin nut fii sch sat org evi umi utu şte vre mpo cte ota sal nei ova eco gan ena rna sea rme epr ras ade doa mpa lea cra emb uno pie amp ega vea rev isc sus iet anţ var nou cie pit roc sec gre exp adr rso lua lim cap dit eal caz upă odu inf roa reş bin uca age pet omu dem lib nui ves viz nţa eea zit ung obl tam esa ias dir ses sub dru adu ved inv ain pes mas clu pra dea jun ară rai cât cad idi dur mea efe cta pub lem fir les sen eau tei soc ote tui nga sto otr alo buc nch etu rtu dor pos ege ibe sol ple nun san ard acă cii ies nos cau nct fec uar mpu hia imb ape nes fra ref foa usi sim mie ove zul azi tes rba uza tfe imu rup rod voi let ngu aci otu osi aþi vat tiu ibi spi ghe oam riu mod bar oua fun ava lio far ozi sil use dio nge afi pte rga îns doi apo ila scr nan lis cup juc tir onf cee dev ovi asu bal rce ogr niz pon niv oto tăţ ict end van det căt irm igu spa ens oad afl tid gal vol azu onc rum uţi del cun rut ema ual ciz rof rog emn ăţi acc mec rge cet gar zon roi diu nse mpi ână ron lig ați ext tig poz mna rmi opu fes evo fla cru dez ble ofi obi tis avu tab sig naţ orb ucu enu ior nua cio lum onu tut rag raf cân sel upr loa inţ ptu ltu ule lel isp fot ida rus vel rcu afa opr stf vut nfo agi ono rne mba pân nev met lam log exi eam auz păr tac zia măr riz ros jur udi erg noa uve mei ziu nom urt him oda dan lni ise fap efi sum ndr gin olt ouă rvi nţe sor nsu nve api rde mir sis tun ize cid ife suc uns vii nsp nţă rid upe gul cce xis rsi pia umu dom ism len pac nec dum scă pop mur rol oba edu sed tud dif pas guv rap deo siu los mor pal bul plu ină ton rni ear ruc împ oce aga fat bor aja bra nto dmi mag epe ură ose dov deţ emo zut ero bit işt adm raţ zin gis mii ncu eor ach pur lev uli înd fon cos via rci eia mpe pin cui abo pii urn het gaz vid mot oap rot fil gru avi opa eat mes dra gri aug cil epa esu eţi fol irc exe ără mpr eră ror sia old arg rla amb tră lus opo rau ţiu ipe mal aşi aic mom rav",
+	"ru": "ени ост про ого ств ста ани тел ова льн тор пре ско при ест ния ров сти нов что енн ред ель стр ние ово рос сто пол ать ком ент оро ите пер тся мен лен тра аст ото ере ски нно оль его ных ной али ает аль ран ног ник нны ист это ков год ден кон раз нос пос тве аци под ить ван ьно том или вер рав ода чес ави дел сси ате етс тер тов пра ове одн ные род кот лов ион так ции иче кой тав каз еск сле сть оло осс ный еле оди ког ном аза вит нии ска ным как рас тро лас был тре иро ват она ход сов вал ина жен рес нал ьны анн вле тан чен бол шен вен все тво кол рем общ еде иде рат дет ако час оры тно ика гра дан спо вод оли кра ами ера нск овы мер мос рез дст ала для тал тат ало ели або дит раб ект буд дер вил оле ико ива тив ори рен сте жно тва авл сво гов дол мин нен ита дов гла ора ают ини соо пор ана едс льс мож ром вос ооб воз оже осл тог бот мес ано нач лис оск ици бра ких вор ело вно цен анс лед йск вед лет еди арт отр нта одо оно ати опр ерн вет асс ока аме гор сло ыва чит обр рит ато ний спе луч стн ери ому соб ним зна зал мет тол дно име суд рин уде сно ная кто бор сам ься ное вре тьс рои анд лей ети вля ила нес кий кие обл мат слу уча вто пар пла они ари лав инс омп ики вой рег але сии лог акт ане ень льк оде дин ерв рал оду лек ожн тур тем ийс сту дни ити ета зак оне уст чно три око иал ови мир тар пан уда чер ене лит онн вск аро ете ино оче орм ела едо сос тны еги гос вое фор лос нию еля ара ько зов нар омо ись кор ьст ман цио лич ерж оги еро щен уже ены вес има пок чет оми нас овн оме кан есс тил орг дат тру ейс обе рог имо рам тст еме лся лан ган нис ция бла нер еда рно обо лиц ими доб орт лат олн пом ена очн мпа олж ава лож нап раи дал обы жет иль тви яет риа вид дар ата нте вае ющи овс дом тит осо там ача ома тич циа льш олу руг вар укр рад мил рон ира йст рое вла явл рот зан бли сег сер ием ено туп скв едн дос выс нит аде чал ерс рма рга ную ади аин зая реш ежд аяв нет ивн чем ило тни евр авн рек ече роп кти нст тоя кци яви убл пот уль бле акж кже мог нтр еще апр нам кам сил ези пис жде инт нак ыми сий екс лад отм ито изв огр тме уще отк ось лик итс еду сре иза рет ине рой нат ять изн щес ует дей тин аки кры зид ека зда ким вых отн аны вол над анк ота нич ляе бще чел рти точ осу низ нед зат оше оте сли ева ниц ору вши рис дру рст ген рок гда кре льт лее авт сан ины есл мно смо ичн ерт ада вны нци отв рук поз тик гол кая сен рев нко иру пас руд вам аво пов амо вый чны тву арс есп мар оба зап ант исп реб пон гио дим вел емя рак опе енк рив риз нее гру дня азв учи ерг зав без сит луж ему изи оти объ жда рия иск рим оку рта наз вин нто одс рац опо дав ютс кур вро ожи еше асн рий аже ыло ква раж рые нят игр мал ака ней пут омм яти оце век чин оре нем озд огд зво лиз газ бил нах аче оиз илл етн ающ руб бан рош тия зац огл руп лаг дна опа жны тоб лли изо уко азо емо меж рна кат рии зад дут льз дне вом нут осн онт клю оит ажд оби пле ась цию сем бщи мит рел ура зыв обс вст овл экс лем жит вог уду тен тыс окр ачи еча свя кла люч вые онс жду люд ваю бес мме рск ующ уме лам реж ево зве дор бер обн орн сла мед чил ага кар счи иса уче исл ыли лом зме чае нан лин рик той воп тир олл тск еко ину ето вов ряд мот бав кру аты нег мол кро нил сей роб сск сед сия зам еся бща емы вую удн сел етр инг инф сот аве чис быт пус вне аре иту дил ахо озм дае ьше ошл ями жив ябр змо щил аем эти рус ида гот бря рны тае спр лжн ших уро иво док дны сяч иня изм иям ичи осе охо тех нка фин поп азы чат вои ице сни нти ату асп анц омн едп вяз ьск рый ыст бир ьни нир урн тот ару аши пло наш оря нео щих них оды пит вра мон вия оек реч щае кое ыся тим аше рай рол исс упр авш соз ире тие нни рож ску вни руж арк уго дес есе юще кта даж енс зра ерк авк ржа эко ози еть рич бел ией езу дев едл две иже дми амм ьта бъе жил пят дек хра оси тои мне тей емь гро сок ндр выб ога сис шин кин уве рвы ола алс лар пад ксп енд усл усс реа иве лев акц ожа нда тка ули аге удо огу рей едв оен овк лив асе лла азн уги сог пец еты нфо рил роц нди пал озн ужб нна ток адо аго онд сет",
+	"sk": "pre ova nie ých pri tor sta ost ove kto ani pod lov est ali kov nov str sti red ého eni ent kon rov ako nej nsk van val tre pro hod slo rok ist tov nos ale pol ven ran ili spo uje pra tak bud men prí ick eho nia ens kom len tra ovi nýc odn ati ast sku rav och tom sto roz sko ich áci voj bol den bra cho ter naj olo rie očn cie edn kej ala oko sla pos dne ovo ved ajú lad čas ste ame oli rad rat vať mal ren iac dov ate lav mer por ver ový rob nom tav tro mie áva ske ten ria cov pov stn prá kla min nem áln ebo teľ jed eda osť era via ite hra kra ude cen néh ori šie ele oto mes ová eur raj alo odo dob lne nik vie ský tie ami tis hla ina ila oro iad avi ové oku ies cia svo tvo ska raz rod hov ekt ovn eto dno stu dos ráv iek res nic dal ujú vne ych dom tal pot ide las anc vor ane dľa isk oje nes pla iel ret isl tri adn lat odľ sia oho cel kol ere orý ene stv tic vod poz uto kýc nto rej nan leb ete som eri oré ede osl mil eko vej sle sme ini edo vol tne zna die odi lan áro tan eli eme ade ení ech poč ach iny ské rom vin rsk pad ilo obi spe adi odp ava nen nep lit pok ave mus per šet ník ero oti lia veľ ano obr dan spr dia med tel aní iat vet ným sve sie ách nis sob ola pom výc akt tia ern reb ada rit led tat nci nár ívn neh par čno orm čný rez kéh for vid ber žia del ený vša rac nut tát zák íci nap bez mov etk vat vys chc môž šak vše ato ank sky rát dis nev elo ado avo ier maj vla roč ari ner dpo vyš lie bil iet edz eno ďal lád aji júc tup ine vlá odu obn obe ole aby nec loč eds ien cký ana edi ore kci keď prv okr rác dzi iná ľud ric čia čne ros nil stá ici and aro tvr mar šte etr nal nam aut usi ivo daj ned ust štá aci ené end sil rot asi nez din cha ádz ním ena zov rus iko ejš čné inu rís jeh omi dol tar vom dok tok omo dvo ote iti oda nič oci hce ied zho man rne fin roj nou tív zač ode ona dst sov nám ban obl aný trá dru ela esi ril ešt otr ora udú reč ika neb ita amo mož zdr esk eck epo ciu pon ebn omu rem áko cké vot ral jej rog žen kým oru apr čov eti opr nás ant ozn tný kor ožn lás ená atk náv íta hol mat zas áto orí dza lom gen obo dni vyh tko ôže kou tné jin dra lam čen dný jem ajt ans ačn ome usk ica hor til avn ozh kus hlá nak níc ruh ces kan dné zni toh art ško dáv úci eľo pev not ými taj epr úča ané iem inf zor ras uró sit róp rek lek lic ara ian ono čít orá ris aco vil chá alš gra nul kal tin oča rep uro tky stí véh eta tva asn emi sed ito ers kaz rip mys ská otn olu nyc rán pen ľov yst ino nad vsk ičn cke dil tik ysl rýc vaj obc lin tál rid kam dop enc eľk tam lep bor toč fot nte emb zid ára emo čil pln ríp ajv vna rev zam itu usí ice ión oka ási onc nky ích rel tot nco túr klu moc ezi zná int sch let erc iar ľad jši krá atr jte chr tej väč kup ndu äčš rce roc živ iál sne bno erá opa ort eľa nfo oji nta žil sam eba ále avy oči ont íko hli azn bal výs ars les sen ntr ajn výr cii kre tsk ávn žno oma orn moh enk dem uti ace ond rak úra fic ves žit atí ňov dro ažd ciá dar vrd zme lny atn tým šen ťaž lko hád rin nav ing osp slu zal ejn uni lej hľa oby rst naš uch mác aka úto avu bli gan ida lis hrá mbr osi zah byť aďa zác nas zat rec sťo zen evi cií udo ému dnu akc ahr oži poh ebu pat iaľ com hto eny zne dla odá sel ísp kde lán los nál mos sad ýva vis tru edy rea iah chl vku oni met fir oso ram zab aso elk byt edk ked šíc ted dmi nko tuj čin zem výš eži odl ieť udí adu udi nka trh epš chu ákl bri anu liz ovs ípa rmá eve iam päť ema evk net dlh ohl zív iká kut det ači des jov odv šta síc vov áme čan súd ojo vra lik poc lil dod ard íte psk ečí dli ečn chy aká íva cit inn plá tur áno otv adr zaj luz any hro záp ico opo kat hos vek ápa stú uži pop jen čak iva onč tiv vel eja ľko tní ady ári dní óps oze ezp odm pan uzí koľ imi izá rto kro nku izo vyp ods nde tna omá nóz jak gnó kva hal jší ogn aký kur rih orú dva ajs aho kaž skú poj cky nti exk iba ďar ion maď cko iln pôs ply xkl ózy štv ozi liv vým esa web úce ôso tla eru olí duj boj tol súč ičo ovú irm voľ nit záv der are vno lió var eľm pis edl jme átn arc osk iky zra enn uvi",
+	"sl": "pre pri ost anj nje sta ega ali red ove sti rav bil eni pra ova udi let sto lov del ili nik ako pos nov ist ila ter pro jen tud nih nos raz naj ija avi ski ven por nsk iti str eli ran ora ani lja oli kov val lje men pod ati est nja ske ite pol ovi slo ijo tem ala kot cij ate olj več elo dru van edn tre lju ovo jan voj tak ilo ajo oči avn jih vse ved enj eva iko ene ije gov rat vlj rad nal nji ena kon ste neg ral pot pov tra med eri jem eda lik sem stv tni gra eno bol vil tev odo ina ogo dob sko mor ira tov ome ori nim elj mer oda dal ime tal nek tav adi kra spo ame ičn ele eta aln nem uje nar vni kat res ika ast aja ese kaj ima eti rja ela led edi tor ara dni rez tek jal rej jav avl rem tro ede svo nam daj vel jsk zna kar etn arj ini alo ent prv ane imi sed dan rij lni nas rug aci ska pom nic oma oto dno roč ice amo bra lah nij kih jev kom las vet eka otr nis kol raj eto bre ahk ens ril var lad ici tan ato ren ave hko dst spr ose nil ava obr zad odn nap išk žav oje odi dnj reb rje min drž ore oro mes čas tel met iji čni čil man ust pla sam ari ale obi ana rov omo sla lan čno gla rep kak vno rit den ica top ano evr rža tri lit sve vor ote dov zar reč bod vro tno ans nju dar oko eds osl čit gle ovn keg rni dil adn čin kup ško sku ače ine erj nes ejo sre eve kri iva jeg naš živ seb lij aro ona igr ere ris rim rih ole blj ide pis sod ada tar ami poz vol oti jub epr zap ita emo ške vid nan iki vod kor era ver olo lic eje spe ete lji ade evi stn cen pog išč rab tva čen lav lno alc sle kem odp rsk rek dra rot upo tis god oča tič iso ozi ank kaz čne ago rok dne tne vne ijs oka nad žen log ogl lač dom pet isk rna loč ški oče gre rod sni dej eja mag saj rom ono ros dol ego anc odl aka jet mar smo niš nav urn eme zel bit tiv rst zni mog nci rev ekt teg apo čan asn obl tve mal iča obe rip hod oji ino ode itv pad tih tvo zma ret ruž zda avo cer ods riš aga nit rno zat arn kal enc aša rič vit iln vez opi dat mis mel jim pon rvi zdr zač žel ben opo zgo mil ejš dlo moč ema nce rop zak gos cev dij nom emb iho orn ars eko sli etj ram vla til bno ogr isa are cel ado jaj par aza nič bor oži vsa nej zav šnj len oja oln vin lne ljš ker ebn rva jud zan tur ins zve oga ugi šte ozn ico nta dos pok nte vem got ivn ubl ves ekm ošk mat emu and imo ike alj ata ajb apr etr zas jši vic ten bli ači sel tir bni nda upa aje nev eče asl nat okr maj gan ile klj one tik ive emi sil esn oni ezn kan otk izk odb lož mov omi čet dje orj šče riz end taj lič ner tko žno sno pel jbo jej tom zur ožn izv nak raš obo azl sic pop rne vaj int apa eči tež ion ivi epo ivo uni ačn opr fin vih opa ber skr akš mem lim zag otn užb ern edo izi rog rin iza jiv ojn ant nač zor api reg asi spl isl rik lce sne riv rač reš rak oce pan fot gal lek onc ort jat kje jše kre abi eza lin azi gor bom sov nčn vij nut vna lom dve dit rve ola per dse etu kim ult ple zen tim les usp pak plo goč žil očn ero des uži ban čak sak slu zir obn ašk zal tin jer hov bro čna nti odr vat ume lil vst čel iri tot voz ure tit osk ugo iče dna nst čal kti jam čev ečj edv lep kšn šal kto šča rob tol ebi šan leg rid ekl odj sal avt veg gen lag kci ajn rib niz mož poš kla ama akt azn rel seg san mre kuš upi boj vpr zij ope inj ota sen iro onč vra nog kle nin jno šči eča com bla piš ebe kod psk vto tvi pin for zme eži bes osp bri zah dok liš njo mlj ajv una eze jni kro kli lci aču dir esa dog ivl zem aki ops zno sis eki amp din ipr ise jon ogi ark dod ece zli edl ase hit zil skl esl orm tna ubi ras rti mno abl eba tje šen mla vič sij ndi aso vaš avs onu gro rdi sme diš vis zpl ake jst vrs dem bel inu jas kam uči roj eže eža ilj toč jša mpa art tru cio cem sek juč žal nep rga izb jel obj lsk aše pus set oru ezo ezp izp rac teh šni sez ord ces ača net sev mam ned ura išl ted ble liz zpo rež ajs itr ons akr tja dpr šel ito lon čun jak fil atk poj kop nec pil dvo uga uge dva rož anč žbe fra abn msk zlo odk izr gol nko trd rka gij dvi etk obč ože rec tat lab dev dro aku nka ton mej ude eks izo jve ial gaj ric jek rji reh",
+	"sv": "för att och det ing ter ill and som nde gen ade den ska til med var rna nin nte sta der har are lig int nge era han ver ett ger ste lle men lan ens ten all ans gar und kan ern lla man kom nga ara ner lar ler sen eri ent inn igt ätt örs upp ell ers son ion age ren arn isk mer dag omm nsk tta rin nna tar iga one lag ran ort tte frå jag ser dan mma fte sam tor ist äge mar sto dra tio ång eda tal tan het ete nne äll tid ven pro ann del art rån ker per säg ock res nen änd ela str sve ati kar när bar spe rar sig nda itt tre des ber ige cke min bli mot stä rad rde yck sin eft mme tra ndr nst est ngs kon lin bet nor rat ord öve get kti kla pel vil tet rig oli dig vis rst ken len ast nar öre ets red ket par sso ats akt ret rik fin nad els ons ick ris sti cka for ina let mat hel eta kri nat nom ram gra någ ess sko bor tad lit pla mil äst äng där tat ilj hon ins tro ite erk ale öra llt vin ari nta tig amm vid bil rit ika tis gör ono änn ant ull lat fra org ark nan ala kte pen arb lis lls rbe vän ågo cen kor oll vär nas lut ras går ikt sla ena tag dni iss pol mån lad ron vår rän opp che nns län ike rät kul led ege här bes cks ott dar nis uta slu kad ate pre väl iti kro lev lde sat ske sät bra sku täl trä lse val ssa nli jäl nds sva ekt stå ise lik kni nal kun kna gån ind erg bla kat skr rka ors ass nse sit rso två ung eck ntr ble end ide ust isa kra las gan gre ola bol van reg sed sök kså had får tni jor fle äns pri rot mis sar ski ele ghe vet igh kli hål ppa kän app kal roc aga riv nni sse åll ars tiv kol tin idi san mål ack ive tur dri läg ytt ppe gon ute rli ien lir hen män por tän tör tik bör ått liv stö rti amt raf oce ags myc rer vad iva kap ida rsk åde tel rte ndl ror ård äve ont run enn änt dem vec hem ban ost hur änk eno örd met nya tch set jon fic orn öst sju far äck rma ora kva ier unn mel ert ali tri rol tas sst elt orm inf dom ild tie rta hol rek åre atc hög got erl ana sda gjo lja rke ede enl rel ndi sad fal vik ygg gru eng hus vit öka ank rda örr väg ärd ämn ton tom ane sjä åst rre rie öte älv ttn fri arl alt pet mun tit aft net ata rra beh bak rts tjä uts ini nke rja pas spr ärl hal näs ani ori emo ine tli mor bro fär bär örj sni aka sch bba ang ald ift nfö ivi råd ona mal rak dli cha råg neb kil lje ere örb mna kta ham lun åga skt olm ard kvi ttr mmu hop avs ode mit fre mas obb lån säk dla dde ljo sak eme mst oms tem rsö lem nsa lva eko mås ärn kas lni sna lli juk gge tse fly ldr gga ckl jan dre älj ame jar tti trö äre rss sty eli dis oma ots rne tes erv eve röm gna äld ägg byg sid jär dda nss rog ssi anl nkt aff oss tsa ult ilk ebä rkl bri nsi ene job rld räd esl lst ärm yra nti alm ubb båd ört gas ben dat rfö spo olk gst das ita köp äga ära lda jer tyr tyc sio hjä edn use iks ads dsk rsä sis tvi nes tts fan gel åri lta åna åte mti ånd lös spa hör sli sma lke dad ågr sik cer mfö abb ral rop rob pan fat rme rse hän amn rup rag erb jus klu les uni ävl emm ppg rem uto hit tve ore mes din ial kel mpe edi vat rkn hef ngd kvä ukt emi stu fas åda mig lek lld rep log ebo erh lyc yst krä nno ngr fol ria tår ama fem her lln tog slä git sex lor ong ino ote ese ärk fot säl als nik ffa sky ngt örl ota möt ckh fil edd gif llb äke ada alv tru årt amh fyr lss lba erä toc nel nit mod kos eln ffe ake sor iet jän utr rod tim kam tyd rge aml rga igg rsv rut nvä seg sfö amp nka kho tsä pte usa bru stn teg jli gat utv rid ami top err lsk öjl omr fåt läm ehö joh ian svå enh pat bäs ond tol möj bel ärs ruk gla rea iel olo äna bät tsl mla rva ire gic try rks kur uti ome rap jun sut pos nhe anv föl ila cia ogr ros äkt agn kör åld omi ksa fta ppl gni llv ppo örh yre nie lic ful bbe inl lub esu häl obl uro ude mär ric ldi mus teb gäl dst agi nsl eni örä vak pek höv erf sol nyt lti ira exp kin apa off örk rum fun tek års emp anf tna orr göt eur ope esk mrå oha ned die eva gsk pps unk tst avg ids låt sys rav lte smi arr bud egi loc adi räf ntl örv kär lär atu ges rsl lam lsa dro öde läs ice ili rör dir räk car väs rhe idn åra tån älp gor ure skä mbe",
+	"tr": "lar ler eri arı ara bir ile lan nda rin ini ili nde esi bil ind ası ele ınd ama ını rın ala ola edi anı den dir ere sin nla eli nin eti dan len alı lma eni tir lir aya rak ine yor anl sın kle nın lik yap uru kla içi iri mas kar mek iye ede rle ekt ana ıla eme çin mak eği ste onu ulu ver ist ilm ada ard iği tan ril ayı man ter rek lam unu ece mal ığı rla gör isi ına eki tar ula kon baş lla mes tür imi gel emi oru lme ene ekl kte ger son abi ari ndi kur ılı kan dil adı der end erl dır öne iyo bul olu yan lem üze lık yar tim erd kul atı aca ren cak ali ken san rma nle aki rum ret irl akt lgi sta ikl tır niz yle tem ilg nun şti kta rme tle apı nma mle kal yet yon ted and ull değ min eye miş rde mad olm bel arl aha üre akl şma rda ağı art yıl rul yer lin mel tek ağl kil uğu ras işi ürk nce und ğin mla yen lay ldu ild tme may say ran diğ pla pro old hal rke met gün net azı lun yük miz dur lış kli çal nel tes tur etm ımı ldi her aşa ebi let ılm işt ite ell enl aşı cek dah etl iti izi men gil yla raf anm irm ğın kat ürü lle nan kad rta yön öre zer eml tal şle rli rar ısı mış asa dar çok tel şla rdi ıkl idi sür aka ort gen amı zel ers sun pıl örü ğer iya ştı dak laş nem liğ tla kay ram una çık kti sti top tin ade uyg ani asi duğ par ygu lın tik are işl bun ibi var leş usu öğr eyi sağ lığ ğre lli rim liy ten ina ünü ırı lec eya nci res öze geç dığ ant ast unl ışm rsi syo ire mey nız lis akı zle dek ser dev ati rdı ans ldı sel sal aşl ğla liş yaz ale kin cil ata umu yat ıyo tıl nme ral mer nlı aza iml diy lim erm ark çek siy bak luş enm inc ise kiy sis tki onr nra vey erk ete opl açı ide nca lab alt sek sle tak gul sor yas ahi sit etk dem ura doğ ket iki dde mam rıl has ışı apa til rım mız tad tti run gib rün ndı ayr ikt böl gra siz din öyl lmi enc üyü lac add kın dik ğun est izm ayn hak ırl eğe tam riy ika kse gir zam asy evl yol arş kim lde ett anc rki mın aşk ila yal ner büy bas düz aba ıra ava era nte mli tın nsa şın ünd şar eçi alm iyl kap zla rne klı nya ill şka tas ksi ütü ban uşt oku izl han dür ştu ici sla ris nli ned şme har lke zin yak lge zde isa yaş mar rşı lek zen dön çer afı rat olo aml düş sil lat rad atl ışt ley mil onl tab evi maz ber uml ogr şim fın vle kes bağ ukl rdu ins ıld iyi erç ırm şek eke acı sah rçe ira nıl ent yın med ank ayl eşi ald ümü dol aşt haz zme ülk del üşü ıyl inl ben ird ğlı mis ğiş rsa rih esa all önc per çla üks cel üle ngi yrı tro tün ürl ora orm yay eks des hiz rti sar oto öst ane mik sen kol oğu gös tic led ğit tör rtı rec loj iğe kas tma ızı dis lid oğr oji ord tiy mem evr ize ıda gis rik kab ölü rog nik ulm nım uyu tra hat mez sat rol kül rlı kiş oyu zar lüm rel muş nak omi kez ern şıl üye üst biz kam azl yin apt lit ıcı rül kra seç ame ayd uma ona dün kom ilk nır üne ünl eşt yüz rev unm ayi eva söz ntı tüm sim fak kir kel ceğ yel tay las gin ate bar üny ekn bin ğil nün bur rlü dav nal hem por ese ıdı azi içe önü hay orl red kor ita cıl nka tli mda şan ıka adi ltı tık ıml dın nat erg üğü okt gun ktı itl luk dış alk mat üni eld akk lır isl ült lad lum get küm aat nek lur yun taş igi cağ kıs sır avr nen eko kem dım leb sya ded tut nar esl ang ucu göz irk nus niy ami ive eçe izd büt mde çev nti hip dal sız irt ven nas ruz nil irs nay oli rup lil ıca uyo yna abe rka zan nir şam for şki yok baz tiğ lus ocu ima eyl far ezi üdü ema lmı pol ont üml ölg yac uzu özl imd oje işk utu mun ınl aye apm aks nab ğru yes çoc dec day att sad açl vre müd ntr niv ükü rac ars sev int çil nim riş one faz roj zın rgi nul aşm zır zıl sma gid urm ray pma özü ime eng van zun bit asl lım önd şün tri osy gru eşm müş yür duy kis ndu zor kaz mah tığ ebe ynı ihi çim afi tif zey bey plu tor lte rab det ğım ktö mut rir hük les sav vam lmu tat urt kıl fer ızl uya işm miy zal ica lıy ker cuk pan abu üve boy apl lak avu bek şik lük raş arm ptı rıc fon car ükl rkl ıll sıl kit ask yab rdü vur rmi söy nam kkı ski güv zda ülü nlu tış mir yıs str dık ono anb ğim riz def tis üşt avi atm ekr şey mac müz nmı şir ınm nbu ülm ple rap nom",
+	"uk": "ого про ння від ськ ати ере кра ува ста пер енн при льн раї іст аїн анн ому укр іль ови ост ько ові оло ван пов них аль ова али пра тьс ься ент ник ити ово ють роз кон ком аці так під ног ьки ний рав одн нов тор тан оро стр ват вал рок роб сто лов сті пре тра ків ред ами ден зна мен лен аст міс оди ист ції ані мін час ися ков сти ної нсь она род буд рез вер кол ніс рів але рос тер мож пол ном алі сть вни дом тис ним или ьог лас ано олі нал кий ако гол віт оку дно том ори ког рим ров дер біл лад овн ими бул три ага ств ода вор ьно обл пор дні орі ала пос ичн ика ают для ідо вно под ідн рад лив тат чер чно бор тим дов ран дин мов сво кої кла тав тів оли чен ьни ить їни рат ійн енк шен нів спо оді ада сту ічн літ пар ких опо арт трі зак єть ідп уть нач вон жен рес ром він год кор лос ері рац ини тво тов авн вин нко які ома ико ара осі сер ато сві раз обо рем рит ерн спр дже нас аро кри яки іні ина всь нар ила одо зав іти ькі ало льш тив ива ені льк рот ади ики ень отр лис ерж дан омі они іон йсь цій най сам чни рив івн ани чин анд люд нос ект тич ита ове над тур оно нав ено ави важ тро сте тал ора нні без каз ход роп їнс уло йог вла нці цьо ову оби екс емо вої асн нен аме гра ній ска оти ньо був иці ька кож уде лиш ійс спі ину вик омо иде дат рен мат зал ржа вих ера рис ана дал ниц пот ест юва орм ган пит пла вод обі дит ези има зид аза вол фор гов дав поч мал ава сло ерш ени оні ору ною сно ман вит оже має тог жав оча акт анс гро дів зап іна вич вся вро тар дни вил икі вні оле ців орг вис нам жит уют лід ося кан кти кре ція туп еле тва кіл ене мог сил нта ули кто вел вог еко ожн зас кар иту виб ряд іше оми зар йно ріш ону ути ивн поз вав вар тин бла аді оці кам лек ять иво нат щен рон тни ніш аєт лог дос вже оду вив нер яви лик пон соб іка пів вид цьк сни арі ату пок рег лод сьо бли зро ача ерт там бут ема бер мер нан рин зах аяв ням слу ино тре ото ачи євр рті лан уль кці анк наш имо ким нув дпо нку рог ині ажа всі аві ець тик вий пом діл бра оси цен гор ття вто нап хов рам ибо тру іда дає зая гал іці окр ючи опе орт заг слі рах дст пис рні оль жна лік осо гат авл змі ери аки ели інш ізн вою уко цію вік иму вій дна ідк вед рик аво вле зви рно дор тув мет рал рек газ ози сій міл ісц інн нтр пан нка ант ьні тел піс льт вим реб ерг ель вир рга уже чит яти сит оме річ ьом дру зов щоб аче іал рак спе або вам ідс уча їні зни жли риз кою лам яко ерс суд якщ кщо ісл осл изн овл нес нст сел рма тіл все ата зац кур май іва ємо ітн уст окі апр оре тит хід нут льс тні вір сов ахо заб лат іло піл ена доб ише икл лав вст озв рет йни дій чат тку юди рев аті усі еде око дум гля бит авт атк онс сля бач сув рий пут лів наг обр ито сан гру иві аго амо ліс баг оте цін іде оне іза нул нім івс иро ією пад роц нни оше йон вля іта чні озп нац бот лін вищ деп зат хоч кер ася гри ола ціо ось бан ями ану уду їна кій очи док доп нте хто скл фра ула ись тно нти аду ніз ока тем авс охо еть ліз онн огр ило дар оки вня ута чал маг гот инк езп ича авд еда омл іть бле ішн сце рай вип рна ьше омп мад ляд ілі між анц ида жив сії нем асо цтв ціа аєм вен ген оде сла егі яка зпо амі нак иве поп лом віл иль кін исл нно ать гос ідт ате вес дон акц обу онт ари маю тве инн ета азн гад ацю луж дем інт іте кув різ овс уря ама оту ідб див итт ерв кат еро мос дуж урн ціє тко ожл епу еви щод ака уєт азу нту айо ліц рук мар лем айб рич озу інф нез сім осу яну жно елі ско ивс тол айн тос арн тсь ирі ляє існ вів чив ших оск нев рти ікт ури ура опр зем заз азо рош име опи огі дут зпе пог рії ніх вда уди віс іно вне вці ває рсь дня нят апи пал зиц руг іве лег упн ите дбу оті умі іни ндр мол ицт риє мир мор дол уні ога ане едс етр еві мон ніч ібн атн ужб нті рни вом леж нок шко рла дія аже енс дув ань вва есі наз зум рій фін дпр упи воє дян адо кул рое тут адя кту нин нед оба одж алу аюч азі нах дес оче арк рол ира реа иса іку дей ісь кал еба мля кли иви нук еди ету ріа арл тет міт лит реч туа бов нши акі нкі теп рил бол ьку огл ргі атр ети фер аси ихо осп рож удо пат дво зьк сяч",
+}
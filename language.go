@@ -0,0 +1,380 @@
+package readability
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// LanguageSource is where the language of the article was found.
+type LanguageSource string
+
+// The sources of the article language, in order of precedence.
+const (
+	// LanguageSourceContentLanguage is the Content-Language HTTP header,
+	// or <meta http-equiv="content-language">.
+	LanguageSourceContentLanguage LanguageSource = "content-language"
+	// LanguageSourceOGLocale is <meta property="og:locale">.
+	LanguageSourceOGLocale LanguageSource = "og:locale"
+	// LanguageSourceJSONLD is inLanguage of the Schema.org article in JSON-LD.
+	LanguageSourceJSONLD LanguageSource = "json-ld"
+	// LanguageSourceDetected is the language detected from the text of
+	// the article.
+	LanguageSourceDetected LanguageSource = "detected"
+	// LanguageSourceHTML is the lang attribute of the document. It's
+	// often left at the default of a template, so it's only used when
+	// the detector agrees with it, or can't tell.
+	LanguageSourceHTML LanguageSource = "html"
+)
+
+// ResolvedLanguage is the language of the article, with the source it was
+// found in. Confidence is between 0 and 1: declared languages always have
+// a confidence of 1, while detected languages have the confidence of the
+// detector.
+type ResolvedLanguage struct {
+	Code       string
+	Source     LanguageSource
+	Confidence float64
+}
+
+const (
+	// languageProfileSize is the number of trigrams kept in each profile.
+	languageProfileSize = 1000
+	// languageMinTrigrams is the least number of trigrams a text must
+	// have for its language to be detected.
+	languageMinTrigrams = 20
+	// languageMaxText is the max number of bytes of text that is used to
+	// detect the language.
+	languageMaxText = 20000
+	// languageMinConfidence is the least confidence of a detected
+	// language. Texts that aren't in any of the known languages, like
+	// lorem ipsum, are usually well below it.
+	languageMinConfidence = 0.06
+	// languageOverrideConfidence is the confidence the detector must
+	// have to override a declared language, e.g. an "en" boilerplate
+	// attribute on a page written in Danish.
+	languageOverrideConfidence = 0.1
+)
+
+// languageScripts are the scripts that are only used by one of the
+// detected languages, so the language is detected by the script alone.
+var languageScripts = []struct {
+	language string
+	script   *unicode.RangeTable
+}{
+	{"ko", unicode.Hangul},
+	{"el", unicode.Greek},
+	{"ar", unicode.Arabic},
+	{"he", unicode.Hebrew},
+	{"th", unicode.Thai},
+	{"hi", unicode.Devanagari},
+}
+
+// languageProfile is the trigram profile of a language.
+type languageProfile struct {
+	language string
+	script   *unicode.RangeTable
+	ranks    map[string]int
+}
+
+// languageProfiles are the profiles of languageTrigrams, which are
+// generated from the corpora of each language by
+// scripts/generate-language-profiles.
+var languageProfiles = buildLanguageProfiles()
+
+// buildLanguageProfiles ranks the trigrams of each language in
+// languageTrigrams, which are listed in order of frequency.
+func buildLanguageProfiles() []languageProfile {
+	languages := make([]string, 0, len(languageTrigrams))
+	for language := range languageTrigrams {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+
+	profiles := make([]languageProfile, 0, len(languages))
+	for _, language := range languages {
+		trigrams := strings.Fields(languageTrigrams[language])
+		ranks := make(map[string]int, len(trigrams))
+		for i, trigram := range trigrams {
+			ranks[trigram] = i
+		}
+
+		script := unicode.Latin
+		if _, nCyrillic := countScript(languageTrigrams[language], unicode.Cyrillic); nCyrillic > 0 {
+			script = unicode.Cyrillic
+		}
+
+		profiles = append(profiles, languageProfile{
+			language: language,
+			script:   script,
+			ranks:    ranks,
+		})
+	}
+
+	return profiles
+}
+
+// DetectLanguage detects the language of text, and returns its ISO 639-1
+// code with the confidence of the detection, between 0 and 1. Chinese,
+// Japanese, Korean and the languages with their own script are detected
+// by the script of the text. The European languages are detected by
+// comparing the trigrams of the text with the trigram profile of each
+// language, using the out-of-place distance by Cavnar and Trenkle. If the
+// language can't be detected with enough confidence, an empty string is
+// returned.
+func DetectLanguage(text string) (string, float64) {
+	if len(text) > languageMaxText {
+		text = strings.ToValidUTF8(text[:languageMaxText], "")
+	}
+
+	// Count the letters of each script
+	nLetters, nHan := countScript(text, unicode.Han)
+	if nLetters == 0 {
+		return "", 0
+	}
+
+	_, nKana := countScript(text, unicode.Hiragana, unicode.Katakana)
+	if nCJK := nHan + nKana; nCJK*2 > nLetters {
+		// Japanese is mostly written with kanji, but any text of some
+		// length has kana too.
+		if nKana*10 > nCJK {
+			return "ja", float64(nCJK) / float64(nLetters)
+		}
+		return "zh", float64(nCJK) / float64(nLetters)
+	}
+
+	for _, item := range languageScripts {
+		if _, n := countScript(text, item.script); n*2 > nLetters {
+			return item.language, float64(n) / float64(nLetters)
+		}
+	}
+
+	_, nLatin := countScript(text, unicode.Latin)
+	_, nCyrillic := countScript(text, unicode.Cyrillic)
+	script := unicode.Latin
+	if nCyrillic > nLatin {
+		script = unicode.Cyrillic
+	}
+
+	// Build the profile of the text
+	counts := make(map[string]int)
+	nTrigrams := 0
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	}) {
+		for _, trigram := range wordTrigrams(word) {
+			counts[trigram]++
+			nTrigrams++
+		}
+	}
+
+	if nTrigrams < languageMinTrigrams {
+		return "", 0
+	}
+
+	// Find the two nearest languages
+	ranks := rankTrigrams(counts)
+	bestLanguage := ""
+	bestDistance, secondDistance := math.MaxInt, math.MaxInt
+	for _, profile := range languageProfiles {
+		if profile.script != script {
+			continue
+		}
+
+		distance := 0
+		for trigram, rank := range ranks {
+			if profileRank, exist := profile.ranks[trigram]; exist {
+				distance += int(math.Abs(float64(rank - profileRank)))
+			} else {
+				distance += languageProfileSize
+			}
+		}
+
+		switch {
+		case distance < bestDistance:
+			bestLanguage, bestDistance, secondDistance = profile.language, distance, bestDistance
+		case distance < secondDistance:
+			secondDistance = distance
+		}
+	}
+
+	if bestLanguage == "" {
+		return "", 0
+	}
+
+	// The confidence is how much nearer the best language is than the
+	// runner-up, relative to the distance of the runner-up.
+	confidence := 1.0
+	if secondDistance != math.MaxInt && secondDistance != 0 {
+		confidence = float64(secondDistance-bestDistance) / float64(secondDistance)
+	}
+
+	if confidence < languageMinConfidence {
+		return "", 0
+	}
+	return bestLanguage, confidence
+}
+
+// wordTrigrams returns the trigrams of word. Like in the profiles, they
+// don't span the boundaries of the word, so words shorter than three
+// letters have none.
+func wordTrigrams(word string) []string {
+	runes := []rune(word)
+	if len(runes) < 3 {
+		return nil
+	}
+
+	trigrams := make([]string, 0, len(runes)-2)
+	for i := 0; i+3 <= len(runes); i++ {
+		trigrams = append(trigrams, string(runes[i:i+3]))
+	}
+	return trigrams
+}
+
+// rankTrigrams ranks the trigrams by their count, and keeps the
+// languageProfileSize most frequent ones. Ties are ranked alphabetically so
+// the ranks are deterministic.
+func rankTrigrams(counts map[string]int) map[string]int {
+	trigrams := make([]string, 0, len(counts))
+	for trigram := range counts {
+		trigrams = append(trigrams, trigram)
+	}
+
+	sort.Slice(trigrams, func(i, j int) bool {
+		if counts[trigrams[i]] != counts[trigrams[j]] {
+			return counts[trigrams[i]] > counts[trigrams[j]]
+		}
+		return trigrams[i] < trigrams[j]
+	})
+
+	if len(trigrams) > languageProfileSize {
+		trigrams = trigrams[:languageProfileSize]
+	}
+
+	ranks := make(map[string]int, len(trigrams))
+	for i, trigram := range trigrams {
+		ranks[trigram] = i
+	}
+	return ranks
+}
+
+// countScript returns the number of letters in text, and the number of
+// them that are in any of the scripts.
+func countScript(text string, scripts ...*unicode.RangeTable) (int, int) {
+	var nLetters, nScript int
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+
+		nLetters++
+		if unicode.In(r, scripts...) {
+			nScript++
+		}
+	}
+	return nLetters, nScript
+}
+
+// getArticleLanguage resolves the language of the article. The languages
+// declared by the metadata are used in order: the Content-Language header
+// or meta, og:locale, then inLanguage in JSON-LD. If none is declared, the
+// language is detected from the text. The lang attribute of the document
+// is the weakest signal: it's used when the detector agrees with it, since
+// it may have a region, or when the detector can't tell, but the detected
+// language wins if the detector is confident that the attribute is wrong.
+func (ps *Parser) getArticleLanguage(contentLanguage string, metadata map[string]string, allMetadata Metadata, text string) ResolvedLanguage {
+	for _, declared := range []ResolvedLanguage{
+		{Code: contentLanguage, Source: LanguageSourceContentLanguage},
+		{Code: allMetadata.Get("content-language"), Source: LanguageSourceContentLanguage},
+		{Code: allMetadata.Get("og:locale"), Source: LanguageSourceOGLocale},
+		{Code: metadata["language"], Source: LanguageSourceJSONLD},
+	} {
+		if declared.Code = normalizeLanguageCode(declared.Code); declared.Code != "" {
+			declared.Confidence = 1
+			return declared
+		}
+	}
+
+	var htmlLang ResolvedLanguage
+	if code := normalizeLanguageCode(ps.articleLang); code != "" {
+		htmlLang = ResolvedLanguage{Code: code, Source: LanguageSourceHTML, Confidence: 1}
+	}
+
+	if ps.DisableLanguageDetection {
+		return htmlLang
+	}
+
+	detected, confidence := DetectLanguage(text)
+	if detected == "" {
+		return htmlLang
+	}
+
+	if htmlLang.Code != "" {
+		htmlPrimary := primaryLanguage(htmlLang.Code)
+		if htmlPrimary == detected || confidence < languageOverrideConfidence || !isDetectableLanguage(htmlPrimary) {
+			return htmlLang
+		}
+		ps.logDebug("lang attribute looks wrong",
+			"lang", htmlLang.Code,
+			"detected", detected,
+			"confidence", confidence)
+	}
+
+	return ResolvedLanguage{
+		Code:       detected,
+		Source:     LanguageSourceDetected,
+		Confidence: confidence,
+	}
+}
+
+// normalizeLanguageCode converts a language tag like "da_DK" into the BCP 47
+// form "da-DK". Only the first tag of a list like "da, en" is used. An empty
+// string is returned if code doesn't look like a language tag.
+func normalizeLanguageCode(code string) string {
+	code, _, _ = strings.Cut(code, ",")
+	code = strings.ReplaceAll(strings.TrimSpace(code), "_", "-")
+
+	subtags := strings.Split(code, "-")
+	if len(subtags[0]) < 2 || len(subtags[0]) > 3 {
+		return ""
+	}
+
+	for _, subtag := range subtags {
+		if subtag == "" || strings.IndexFunc(subtag, func(r rune) bool {
+			return r > unicode.MaxASCII || (!unicode.IsLetter(r) && !unicode.IsDigit(r))
+		}) >= 0 {
+			return ""
+		}
+	}
+
+	subtags[0] = strings.ToLower(subtags[0])
+	return strings.Join(subtags, "-")
+}
+
+// primaryLanguage returns the primary subtag of a language tag, as it's
+// returned by DetectLanguage. Norwegian is always detected as Bokmål.
+func primaryLanguage(code string) string {
+	primary, _, _ := strings.Cut(strings.ToLower(code), "-")
+	switch primary {
+	case "no", "nn":
+		return "nb"
+	default:
+		return primary
+	}
+}
+
+// isDetectableLanguage returns true if DetectLanguage is able to detect
+// the language.
+func isDetectableLanguage(language string) bool {
+	if _, exist := languageTrigrams[language]; exist || language == "zh" || language == "ja" {
+		return true
+	}
+
+	for _, item := range languageScripts {
+		if item.language == language {
+			return true
+		}
+	}
+	return false
+}
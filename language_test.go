@@ -0,0 +1,134 @@
+package readability
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func Test_DetectLanguage(t *testing.T) {
+	scenarios := map[string]string{
+		"da": "Vi søger en erfaren udvikler, som har lyst til at arbejde med moderne teknologier i et stærkt team. Du får ansvar for udvikling og drift af vores platform, og du vil få mulighed for at præge arbejdet fra dag ét.",
+		"nb": "Vi søker en erfaren utvikler som har lyst til å jobbe med moderne teknologier i et sterkt team. Du får ansvar for utvikling og drift av vår plattform, og du vil få mulighet til å påvirke arbeidet fra første dag.",
+		"sv": "Vi söker en erfaren utvecklare som vill arbeta med moderna tekniker i ett starkt team. Du får ansvar för utveckling och drift av vår plattform, och du kommer att få möjlighet att påverka arbetet från första dagen.",
+		"de": "Wir suchen einen erfahrenen Entwickler, der Lust hat, mit modernen Technologien in einem starken Team zu arbeiten. Sie übernehmen die Verantwortung für die Entwicklung und den Betrieb unserer Plattform.",
+		"nl": "Wij zoeken een ervaren ontwikkelaar die zin heeft om met moderne technologieën in een sterk team te werken. Je bent verantwoordelijk voor de ontwikkeling en het beheer van ons platform.",
+		"en": "We are looking for an experienced developer who wants to work with modern technologies in a strong team. You will be responsible for the development and operation of our platform.",
+		"pl": "Szukamy doświadczonego programisty, który chce pracować z nowoczesnymi technologiami w silnym zespole. Będziesz odpowiedzialny za rozwój i utrzymanie naszej platformy.",
+		"ru": "Мы ищем опытного разработчика, который хочет работать с современными технологиями в сильной команде. Вы будете отвечать за развитие и поддержку нашей платформы.",
+		"ja": "私たちは、強いチームで最新の技術を使って働きたい経験豊富な開発者を探しています。",
+		"zh": "我们正在寻找一位经验丰富的开发人员，希望在强大的团队中使用现代技术工作。",
+		"ko": "우리는 강력한 팀에서 최신 기술로 일하고 싶은 경험 많은 개발자를 찾고 있습니다.",
+		"":   "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore et dolore magna aliqua.",
+	}
+
+	for expected, text := range scenarios {
+		language, confidence := DetectLanguage(text)
+		if language != expected {
+			t.Errorf("text %.20q, want %q got %q (%.3f)", text, expected, language, confidence)
+		}
+		if (language == "") != (confidence == 0) || confidence > 1 {
+			t.Errorf("text %.20q, confidence out of range: %f", text, confidence)
+		}
+	}
+
+	if language, _ := DetectLanguage("Hej med dig"); language != "" {
+		t.Errorf("short text, want no language got %q", language)
+	}
+}
+
+func Test_getArticleLanguage(t *testing.T) {
+	danish := strings.Repeat("Vi søger en erfaren udvikler, som har lyst til at arbejde med moderne teknologier i et stærkt team. ", 6)
+
+	scenarios := []struct {
+		name            string
+		page            string
+		contentLanguage string
+		expected        ResolvedLanguage
+	}{{
+		name:     "html lang",
+		page:     `<html lang="da-DK"><body><article><p>` + danish + `</p></article></body></html>`,
+		expected: ResolvedLanguage{"da-DK", LanguageSourceHTML, 1},
+	}, {
+		name:            "content-language header",
+		page:            `<html><head><meta http-equiv="Content-Language" content="nb"></head><body><article><p>` + danish + `</p></article></body></html>`,
+		contentLanguage: "da, en",
+		expected:        ResolvedLanguage{"da", LanguageSourceContentLanguage, 1},
+	}, {
+		name:     "content-language meta",
+		page:     `<html><head><meta http-equiv="Content-Language" content="da"></head><body><article><p>` + danish + `</p></article></body></html>`,
+		expected: ResolvedLanguage{"da", LanguageSourceContentLanguage, 1},
+	}, {
+		name:     "og:locale",
+		page:     `<html><head><meta property="og:locale" content="da_DK"></head><body><article><p>` + danish + `</p></article></body></html>`,
+		expected: ResolvedLanguage{"da-DK", LanguageSourceOGLocale, 1},
+	}, {
+		name:     "json-ld",
+		page:     `<html><head><script type="application/ld+json">{"@context": "https://schema.org", "@type": "Article", "inLanguage": "da"}</script></head><body><article><p>` + danish + `</p></article></body></html>`,
+		expected: ResolvedLanguage{"da", LanguageSourceJSONLD, 1},
+	}, {
+		name:     "declared language is wrong",
+		page:     `<html lang="en"><body><article><p>` + danish + `</p></article></body></html>`,
+		expected: ResolvedLanguage{"da", LanguageSourceDetected, 0},
+	}, {
+		name:     "metadata wins over html lang",
+		page:     `<html lang="en"><head><meta property="og:locale" content="da_DK"></head><body><article><p>` + danish + `</p></article></body></html>`,
+		expected: ResolvedLanguage{"da-DK", LanguageSourceOGLocale, 1},
+	}, {
+		name:            "content-language header wins over the detector",
+		page:            `<html lang="en"><body><article><p>` + danish + `</p></article></body></html>`,
+		contentLanguage: "nb",
+		expected:        ResolvedLanguage{"nb", LanguageSourceContentLanguage, 1},
+	}, {
+		name:     "detected without declared language",
+		page:     `<html><body><article><p>` + danish + `</p></article></body></html>`,
+		expected: ResolvedLanguage{"da", LanguageSourceDetected, 0},
+	}, {
+		name:     "unknown declared language is kept",
+		page:     `<html lang="fo"><body><article><p>` + danish + `</p></article></body></html>`,
+		expected: ResolvedLanguage{"fo", LanguageSourceHTML, 1},
+	}}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			parser := NewParser()
+			article, err := parser.parseInput(context.Background(), strings.NewReader(scenario.page), fakeHostURL, "", scenario.contentLanguage)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resolved := article.ResolvedLanguage
+			if resolved.Code != scenario.expected.Code || resolved.Source != scenario.expected.Source {
+				t.Errorf("want %q from %q, got %q from %q", scenario.expected.Code, scenario.expected.Source, resolved.Code, resolved.Source)
+			}
+
+			if scenario.expected.Source == LanguageSourceDetected {
+				if resolved.Confidence < languageOverrideConfidence {
+					t.Errorf("detected language, confidence %f too low", resolved.Confidence)
+				}
+			} else if resolved.Confidence != scenario.expected.Confidence {
+				t.Errorf("confidence, want %f got %f", scenario.expected.Confidence, resolved.Confidence)
+			}
+		})
+	}
+}
+
+func Test_normalizeLanguageCode(t *testing.T) {
+	scenarios := map[string]string{
+		"da_DK":      "da-DK",
+		" EN-us ":    "en-us",
+		"da, en":     "da",
+		"zh-Hant-TW": "zh-Hant-TW",
+		"english":    "",
+		"d":          "",
+		"en--US":     "",
+		"{{ lang }}": "",
+		"":           "",
+	}
+
+	for code, expected := range scenarios {
+		if normalized := normalizeLanguageCode(code); normalized != expected {
+			t.Errorf("code %q, want %q got %q", code, expected, normalized)
+		}
+	}
+}
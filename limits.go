@@ -0,0 +1,211 @@
+package readability
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"slices"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// limitedReader reads from r until more than max bytes are read, then fails
+// with *InputTooLargeError.
+type limitedReader struct {
+	r    io.Reader
+	max  int64
+	read int64
+}
+
+func (lr *limitedReader) Read(p []byte) (int, error) {
+	// Read one byte past the limit, to tell an input of exactly max
+	// bytes from a larger one.
+	if remaining := lr.max + 1 - lr.read; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+
+	n, err := lr.r.Read(p)
+	lr.read += int64(n)
+	if lr.read > lr.max {
+		return n, &InputTooLargeError{Max: lr.max}
+	}
+	return n, err
+}
+
+// defaultScope are the elements that limit the scope in which a start tag
+// closes the open elements, as defined by the HTML parsing algorithm.
+var defaultScope = []atom.Atom{
+	atom.Applet, atom.Caption, atom.Html, atom.Table, atom.Td, atom.Th,
+	atom.Marquee, atom.Object, atom.Template,
+}
+
+// tableScope are the elements that limit the scope of the start tags of
+// the rows and cells of a table.
+var tableScope = []atom.Atom{atom.Html, atom.Table, atom.Tbody, atom.Tfoot, atom.Thead, atom.Template}
+
+// impliedEndTag describes the open elements that are closed by a start tag,
+// since their end tag may be omitted: the last open element of one of the
+// kinds in closes, with the elements opened after it, unless an element of
+// one of the kinds in scope is found first.
+type impliedEndTag struct {
+	closes []atom.Atom
+	scope  []atom.Atom
+}
+
+// impliedEndTags are the start tags that close the open elements whose end
+// tag was omitted, e.g. <li> closes the previous <li> of the list with the
+// <p> in it, and <tr> closes the previous row with its open cell.
+var impliedEndTags = map[atom.Atom]impliedEndTag{
+	atom.Li:       {closes: []atom.Atom{atom.Li}, scope: append([]atom.Atom{atom.Ol, atom.Ul}, defaultScope...)},
+	atom.Dt:       {closes: []atom.Atom{atom.Dt, atom.Dd}, scope: append([]atom.Atom{atom.Dl}, defaultScope...)},
+	atom.Dd:       {closes: []atom.Atom{atom.Dt, atom.Dd}, scope: append([]atom.Atom{atom.Dl}, defaultScope...)},
+	atom.Tr:       {closes: []atom.Atom{atom.Tr}, scope: tableScope},
+	atom.Td:       {closes: []atom.Atom{atom.Td, atom.Th}, scope: append([]atom.Atom{atom.Tr}, tableScope...)},
+	atom.Th:       {closes: []atom.Atom{atom.Td, atom.Th}, scope: append([]atom.Atom{atom.Tr}, tableScope...)},
+	atom.Option:   {closes: []atom.Atom{atom.Option}, scope: []atom.Atom{atom.Select, atom.Datalist, atom.Optgroup}},
+	atom.Optgroup: {closes: []atom.Atom{atom.Option, atom.Optgroup}, scope: []atom.Atom{atom.Select, atom.Datalist}},
+	atom.Rt:       {closes: []atom.Atom{atom.Rt, atom.Rp}, scope: []atom.Atom{atom.Ruby}},
+	atom.Rp:       {closes: []atom.Atom{atom.Rt, atom.Rp}, scope: []atom.Atom{atom.Ruby}},
+}
+
+// closesParagraph determines if the start tag of the element closes an open
+// <p>, like the block elements do.
+func closesParagraph(a atom.Atom) bool {
+	switch a {
+	case atom.Address, atom.Article, atom.Aside, atom.Blockquote, atom.Center,
+		atom.Details, atom.Dialog, atom.Dir, atom.Div, atom.Dl, atom.Fieldset,
+		atom.Figcaption, atom.Figure, atom.Footer, atom.Form, atom.H1, atom.H2,
+		atom.H3, atom.H4, atom.H5, atom.H6, atom.Header, atom.Hgroup, atom.Hr,
+		atom.Li, atom.Dd, atom.Dt, atom.Listing, atom.Main, atom.Menu, atom.Nav,
+		atom.Ol, atom.P, atom.Pre, atom.Section, atom.Summary, atom.Table, atom.Ul:
+		return true
+	}
+	return false
+}
+
+// paragraphEndTag closes an open <p> in button scope.
+var paragraphEndTag = impliedEndTag{
+	closes: []atom.Atom{atom.P},
+	scope:  append([]atom.Atom{atom.Button}, defaultScope...),
+}
+
+// openElement is an element that is open while the input is tokenized. The
+// name is only set for the elements that aren't known atoms.
+type openElement struct {
+	atom atom.Atom
+	name string
+}
+
+// checkInputLimits tokenizes r to check MaxElemsToParse and MaxNestingDepth
+// before the DOM is built, so a huge document is rejected without building
+// its tree, and without reading past the element that exceeds a limit.
+//
+// The elements are counted by their start tags, so the elements that are
+// implied by the HTML parser, like <html> and <body>, are only counted
+// later on the DOM. The depth is tracked on the tags too: an end tag closes
+// the open elements up to the matching one, and a start tag closes the
+// elements whose end tag it implies, as listed in impliedEndTags.
+func (ps *Parser) checkInputLimits(r io.Reader) error {
+	var stack []openElement
+	nElements, line := 0, 1
+	tokenizer := html.NewTokenizer(r)
+
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			break
+		}
+
+		switch tokenType {
+		case html.StartTagToken, html.SelfClosingTagToken:
+			nElements++
+			if ps.MaxElemsToParse > 0 && nElements > ps.MaxElemsToParse {
+				return &TooManyElementsError{Count: nElements, Max: ps.MaxElemsToParse}
+			}
+
+			if ps.MaxNestingDepth <= 0 || tokenType == html.SelfClosingTagToken {
+				break
+			}
+
+			name, _ := tokenizer.TagName()
+			element := openElement{atom: atom.Lookup(name)}
+			if element.atom == 0 {
+				element.name = string(name)
+			}
+
+			if implied, exist := impliedEndTags[element.atom]; exist {
+				stack = closeImpliedEndTag(stack, implied)
+			}
+			if closesParagraph(element.atom) {
+				stack = closeImpliedEndTag(stack, paragraphEndTag)
+			}
+
+			if isVoidAtom(element.atom) {
+				break
+			}
+
+			stack = append(stack, element)
+			if len(stack) > ps.MaxNestingDepth {
+				return &TooDeeplyNestedError{Max: ps.MaxNestingDepth, Line: line}
+			}
+
+		case html.EndTagToken:
+			if ps.MaxNestingDepth <= 0 {
+				break
+			}
+
+			name, _ := tokenizer.TagName()
+			element := openElement{atom: atom.Lookup(name)}
+			if element.atom == 0 {
+				element.name = string(name)
+			}
+
+			// Stray end tags are ignored, like the HTML parser does
+			for i := len(stack) - 1; i >= 0; i-- {
+				if stack[i] == element {
+					stack = stack[:i]
+					break
+				}
+			}
+		}
+
+		line += bytes.Count(tokenizer.Raw(), []byte("\n"))
+	}
+
+	if err := tokenizer.Err(); !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
+
+// closeImpliedEndTag closes the last open element in stack that is closed
+// by implied, with the elements opened after it, and returns the remaining
+// stack. Nothing is closed if an element that limits the scope of implied
+// is found first.
+func closeImpliedEndTag(stack []openElement, implied impliedEndTag) []openElement {
+	for i := len(stack) - 1; i >= 0; i-- {
+		if stack[i].name != "" {
+			continue
+		}
+		if slices.Contains(implied.closes, stack[i].atom) {
+			return stack[:i]
+		}
+		if slices.Contains(implied.scope, stack[i].atom) {
+			break
+		}
+	}
+	return stack
+}
+
+// isVoidAtom determines if the element can't have any children, so it's
+// never left open.
+func isVoidAtom(a atom.Atom) bool {
+	switch a {
+	case atom.Area, atom.Base, atom.Br, atom.Col, atom.Embed, atom.Hr,
+		atom.Img, atom.Input, atom.Keygen, atom.Link, atom.Meta,
+		atom.Param, atom.Source, atom.Track, atom.Wbr:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package readability
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func Test_parseLimits(t *testing.T) {
+	paragraphs := strings.Repeat("<p>Lorem ipsum dolor sit amet, consectetur adipiscing elit.</p>\n", 20)
+	page := "<html><body><article>" + paragraphs + "</article></body></html>"
+
+	scenarios := map[string]struct {
+		source  string
+		prepare func(*Parser)
+		want    error
+	}{
+		"input too large": {
+			source:  page,
+			prepare: func(ps *Parser) { ps.MaxInputBytes = int64(len(page)) - 1 },
+			want:    ErrInputTooLarge,
+		},
+		"input at the limit": {
+			source:  page,
+			prepare: func(ps *Parser) { ps.MaxInputBytes = int64(len(page)) },
+		},
+		"too many start tags": {
+			source:  page,
+			prepare: func(ps *Parser) { ps.MaxElemsToParse = 20 },
+			want:    ErrTooManyElements,
+		},
+		"too deeply nested": {
+			source:  "<html><body>" + strings.Repeat("<div>", 50) + paragraphs + strings.Repeat("</div>", 50) + "</body></html>",
+			prepare: func(ps *Parser) { ps.MaxNestingDepth = 32 },
+			want:    ErrTooDeeplyNested,
+		},
+		"closed elements": {
+			source:  "<html><body>" + strings.Repeat("<div><span>x</span></div>", 50) + paragraphs + "</body></html>",
+			prepare: func(ps *Parser) { ps.MaxNestingDepth = 5 },
+		},
+		"omitted end tags": {
+			source:  "<html><body><ul>" + strings.Repeat("<li>Item", 50) + "</ul><dl>" + strings.Repeat("<dt>Term<dd>Definition", 50) + "</dl>" + paragraphs + "</body></html>",
+			prepare: func(ps *Parser) { ps.MaxNestingDepth = 5 },
+		},
+		"omitted table end tags": {
+			source:  "<html><body><table>" + strings.Repeat("<tr><td>Cell<td><p>Cell", 100) + "</table>" + paragraphs + "</body></html>",
+			prepare: func(ps *Parser) { ps.MaxNestingDepth = 8 },
+		},
+		"list items with paragraphs": {
+			source:  "<html><body><ul>" + strings.Repeat("<li><p>Item<p>More", 100) + "</ul>" + paragraphs + "</body></html>",
+			prepare: func(ps *Parser) { ps.MaxNestingDepth = 8 },
+		},
+		"paragraphs closed by blocks": {
+			source:  "<html><body>" + strings.Repeat("<p>Text<div>Block</div><p>Text<hr>", 100) + paragraphs + "</body></html>",
+			prepare: func(ps *Parser) { ps.MaxNestingDepth = 8 },
+		},
+		"nested tables": {
+			source:  "<html><body>" + strings.Repeat("<table><tr><td>", 20) + paragraphs + "</body></html>",
+			prepare: func(ps *Parser) { ps.MaxNestingDepth = 32 },
+			want:    ErrTooDeeplyNested,
+		},
+		"void and raw text elements": {
+			source:  "<html><body>" + strings.Repeat("<br><img src=a.png>", 50) + "<script>" + strings.Repeat("<div>", 50) + "</script>" + paragraphs + "</body></html>",
+			prepare: func(ps *Parser) { ps.MaxNestingDepth = 5 },
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t1 *testing.T) {
+			parser := NewParser()
+			parser.CharThresholds = 100
+			scenario.prepare(&parser)
+
+			_, err := parser.Parse(strings.NewReader(scenario.source), fakeHostURL)
+			if scenario.want == nil && err != nil {
+				t1.Errorf("want no error got %v\n", err)
+			} else if !errors.Is(err, scenario.want) {
+				t1.Errorf("want %v got %v\n", scenario.want, err)
+			}
+		})
+	}
+}
+
+func Test_checkInputLimits(t *testing.T) {
+	source := "<html>\n<body>\n<div>\n<div>\n<div><p>Too deep</p></div>\n</div>\n</div>\n</body>\n</html>"
+
+	parser := NewParser()
+	parser.MaxNestingDepth = 4
+	err := parser.checkInputLimits(strings.NewReader(source))
+
+	var tooDeep *TooDeeplyNestedError
+	if !errors.As(err, &tooDeep) || tooDeep.Max != 4 || tooDeep.Line != 5 {
+		t.Errorf("want *TooDeeplyNestedError at line 5 got %v\n", err)
+	}
+
+	parser = NewParser()
+	parser.MaxElemsToParse = 4
+	err = parser.checkInputLimits(strings.NewReader(source))
+
+	var tooMany *TooManyElementsError
+	if !errors.As(err, &tooMany) || tooMany.Count != 5 || tooMany.Max != 4 {
+		t.Errorf("want *TooManyElementsError with 5 elements got %v\n", err)
+	}
+}
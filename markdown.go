@@ -0,0 +1,520 @@
+package readability
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-shiori/dom"
+	"golang.org/x/net/html"
+)
+
+// blockElems are the elements that are rendered as blocks by the Markdown
+// and plain text renderers. Any other element is rendered inline, as part of
+// a paragraph.
+var blockElems = sliceToMap(
+	"address", "article", "aside", "blockquote", "center", "dd", "details",
+	"div", "dl", "dt", "figcaption", "figure", "footer", "h1", "h2", "h3",
+	"h4", "h5", "h6", "header", "hr", "li", "main", "nav", "ol", "p", "pre",
+	"section", "summary", "table", "ul")
+
+// Markdown renders the article content as CommonMark. Tables and struck
+// through text use the GitHub Flavored Markdown extensions.
+func (article Article) Markdown() string {
+	if article.Node == nil {
+		return ""
+	}
+	return RenderMarkdown(article.Node)
+}
+
+// RenderMarkdown renders node and its descendants as CommonMark. Tables and
+// struck through text use the GitHub Flavored Markdown extensions.
+func RenderMarkdown(node *html.Node) string {
+	if isBlockElement(node) {
+		return mdBlock(node)
+	}
+	return mdParagraph(mdInline(node))
+}
+
+// isBlockElement determines if node is rendered as a block.
+func isBlockElement(node *html.Node) bool {
+	_, isBlock := blockElems[dom.TagName(node)]
+	return node.Type == html.ElementNode && isBlock
+}
+
+// mdBlocks renders the children of node as blocks separated by blank lines.
+func mdBlocks(node *html.Node) string {
+	return strings.Join(mdBlockList(node), "\n\n")
+}
+
+// mdBlockList renders the children of node as a list of blocks. Consecutive
+// inline children are grouped into a single paragraph.
+func mdBlockList(node *html.Node) []string {
+	var blocks []string
+	var inline strings.Builder
+
+	flushInline := func() {
+		if paragraph := mdParagraph(inline.String()); paragraph != "" {
+			blocks = append(blocks, paragraph)
+		}
+		inline.Reset()
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if !isBlockElement(child) {
+			inline.WriteString(mdInline(child))
+			continue
+		}
+
+		flushInline()
+		if block := mdBlock(child); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+
+	flushInline()
+	return blocks
+}
+
+// mdBlock renders a single block element.
+func mdBlock(node *html.Node) string {
+	switch tagName := dom.TagName(node); tagName {
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		level, _ := strconv.Atoi(tagName[1:])
+		text := mdSingleLine(mdInlineChildren(node))
+		if text == "" {
+			return ""
+		}
+		return strings.Repeat("#", level) + " " + text
+
+	case "p":
+		return mdParagraph(mdInlineChildren(node))
+
+	case "ul", "ol":
+		return mdList(node)
+
+	case "li":
+		return mdListItem("- ", node)
+
+	case "blockquote":
+		return prefixLines(mdBlocks(node), "> ", ">")
+
+	case "pre":
+		return mdCodeBlock(node)
+
+	case "hr":
+		return "---"
+
+	case "table":
+		return mdTable(node)
+
+	default:
+		return mdBlocks(node)
+	}
+}
+
+// mdParagraph cleans up the inline Markdown of a paragraph: whitespace is
+// collapsed, lines are trimmed, and characters that would otherwise start
+// a block (like "#" or "1.") are escaped.
+func mdParagraph(inline string) string {
+	var lines []string
+	for _, line := range strings.Split(inline, "\n") {
+		line = strings.Trim(collapseSpaces(line), " ")
+		if line == "" || line == "\\" {
+			continue
+		}
+		lines = append(lines, escapeBlockStart(line))
+	}
+
+	// A hard line break at the end of a paragraph is meaningless.
+	if n := len(lines); n > 0 {
+		lines[n-1] = strings.TrimRight(strings.TrimSuffix(lines[n-1], "\\"), " ")
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// mdSingleLine renders inline Markdown as a single line, for the places
+// where hard line breaks aren't allowed such as headings and table cells.
+func mdSingleLine(inline string) string {
+	inline = strings.ReplaceAll(inline, "\\\n", " ")
+	inline = strings.ReplaceAll(inline, "\n", " ")
+	return escapeBlockStart(strings.Trim(collapseSpaces(inline), " "))
+}
+
+// mdInlineChildren renders the children of node as inline Markdown.
+func mdInlineChildren(node *html.Node) string {
+	var sb strings.Builder
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		sb.WriteString(mdInline(child))
+	}
+	return sb.String()
+}
+
+// mdInline renders node as inline Markdown. Block elements that are nested
+// inside inline ones are flattened and separated by spaces.
+func mdInline(node *html.Node) string {
+	switch node.Type {
+	case html.TextNode:
+		return escapeMarkdown(collapseSpaces(node.Data))
+	case html.ElementNode:
+	default:
+		return ""
+	}
+
+	switch dom.TagName(node) {
+	case "script", "style", "noscript", "template":
+		return ""
+
+	case "br":
+		return "\\\n"
+
+	case "img":
+		return mdImage(node)
+
+	case "a":
+		return mdLink(node)
+
+	case "em", "i":
+		return wrapInline(mdInlineChildren(node), "*")
+
+	case "strong", "b":
+		return wrapInline(mdInlineChildren(node), "**")
+
+	case "del", "s", "strike":
+		return wrapInline(mdInlineChildren(node), "~~")
+
+	case "code", "kbd", "samp", "tt":
+		return mdCodeSpan(dom.TextContent(node))
+	}
+
+	if isBlockElement(node) {
+		return " " + mdInlineChildren(node) + " "
+	}
+
+	return mdInlineChildren(node)
+}
+
+// wrapInline wraps the inline Markdown with the emphasis marker. Leading and
+// trailing spaces are kept outside of the marker, otherwise it won't be
+// recognized as emphasis.
+func wrapInline(inline, marker string) string {
+	trimmed := strings.Trim(inline, " ")
+	if trimmed == "" {
+		return inline
+	}
+
+	leading := inline[:strings.Index(inline, trimmed)]
+	trailing := inline[len(leading)+len(trimmed):]
+	return leading + marker + trimmed + marker + trailing
+}
+
+// mdLink renders <a> as Markdown link.
+func mdLink(node *html.Node) string {
+	text := mdInlineChildren(node)
+	href := strings.TrimSpace(dom.GetAttribute(node, "href"))
+	if href == "" {
+		return text
+	}
+
+	if strings.Trim(text, " ") == "" {
+		text = escapeMarkdown(href)
+	}
+
+	return wrapLink("["+strings.Trim(text, " ")+"]", href, dom.GetAttribute(node, "title"))
+}
+
+// mdImage renders <img> as Markdown image.
+func mdImage(node *html.Node) string {
+	src := strings.TrimSpace(dom.GetAttribute(node, "src"))
+	if src == "" {
+		return ""
+	}
+
+	alt := escapeMarkdown(collapseSpaces(dom.GetAttribute(node, "alt")))
+	return wrapLink("!["+strings.Trim(alt, " ")+"]", src, dom.GetAttribute(node, "title"))
+}
+
+// wrapLink appends the destination and the optional title to the link text.
+func wrapLink(text, destination, title string) string {
+	if strings.ContainsAny(destination, " ()<>") {
+		destination = "<" + strings.NewReplacer("<", "%3C", ">", "%3E").Replace(destination) + ">"
+	}
+
+	if title = strings.TrimSpace(title); title != "" {
+		return fmt.Sprintf("%s(%s %q)", text, destination, collapseSpaces(title))
+	}
+
+	return text + "(" + destination + ")"
+}
+
+// mdCodeSpan renders text as inline code. The backtick fence is made longer
+// than any run of backticks inside the text.
+func mdCodeSpan(text string) string {
+	text = collapseSpaces(text)
+	if strings.Trim(text, " ") == "" {
+		return text
+	}
+
+	fence := "`"
+	for strings.Contains(text, fence) {
+		fence += "`"
+	}
+
+	if strings.HasPrefix(text, "`") || strings.HasSuffix(text, "`") {
+		text = " " + text + " "
+	}
+
+	return fence + text + fence
+}
+
+// mdCodeBlock renders <pre> as fenced code block, keeping its whitespace.
+func mdCodeBlock(pre *html.Node) string {
+	code := strings.TrimRight(preformattedText(pre), "\n")
+	if strings.TrimSpace(code) == "" {
+		return ""
+	}
+
+	fence := "```"
+	for strings.Contains(code, fence) {
+		fence += "`"
+	}
+
+	return fence + codeLanguage(pre) + "\n" + code + "\n" + fence
+}
+
+// preformattedText returns the text content of node as is, except <br>
+// which is converted to new line.
+func preformattedText(node *html.Node) string {
+	var sb strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		switch {
+		case n.Type == html.TextNode:
+			sb.WriteString(n.Data)
+		case dom.TagName(n) == "br":
+			sb.WriteString("\n")
+		}
+
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+
+	walk(node)
+	return sb.String()
+}
+
+// codeLanguage returns the language of a code block, as specified by the
+// "language-*" or "lang-*" class of <pre> or its <code>.
+func codeLanguage(pre *html.Node) string {
+	classNames := dom.ClassName(pre)
+	if code := dom.FirstElementChild(pre); code != nil && dom.TagName(code) == "code" {
+		classNames += " " + dom.ClassName(code)
+	}
+
+	for _, className := range strings.Fields(classNames) {
+		for _, prefix := range []string{"language-", "lang-"} {
+			if strings.HasPrefix(className, prefix) && len(className) > len(prefix) {
+				return strings.TrimPrefix(className, prefix)
+			}
+		}
+	}
+
+	return ""
+}
+
+// mdList renders <ul> and <ol> as Markdown list. The list is loose, i.e.
+// its items are separated by blank lines, if any item contains a paragraph.
+func mdList(list *html.Node) string {
+	ordered := dom.TagName(list) == "ol"
+	number := 1
+	if start, err := strconv.Atoi(dom.GetAttribute(list, "start")); ordered && err == nil {
+		number = start
+	}
+
+	var items []string
+	var marker string
+	loose := false
+	for child := dom.FirstElementChild(list); child != nil; child = dom.NextElementSibling(child) {
+		// Lists that are nested directly inside another list belong to
+		// the previous item.
+		if tagName := dom.TagName(child); (tagName == "ul" || tagName == "ol") && len(items) > 0 {
+			if nested := mdList(child); nested != "" {
+				indent := strings.Repeat(" ", len(marker))
+				items[len(items)-1] += "\n" + prefixLines(nested, indent, "")
+			}
+			continue
+		}
+
+		marker = "- "
+		if ordered {
+			marker = strconv.Itoa(number) + ". "
+			number++
+		}
+
+		if len(dom.GetElementsByTagName(child, "p")) > 0 {
+			loose = true
+		}
+
+		items = append(items, mdListItem(marker, child))
+	}
+
+	if loose {
+		return strings.Join(items, "\n\n")
+	}
+	return strings.Join(items, "\n")
+}
+
+// mdListItem renders a list item. The lines after the first one are
+// indented so they stay inside the item.
+func mdListItem(marker string, item *html.Node) string {
+	blocks := mdBlockList(item)
+	separator := "\n"
+	if len(dom.GetElementsByTagName(item, "p")) > 0 {
+		separator = "\n\n"
+	}
+
+	content := strings.Join(blocks, separator)
+	if content == "" {
+		return strings.TrimSpace(marker)
+	}
+
+	indent := strings.Repeat(" ", len(marker))
+	return marker + strings.TrimPrefix(prefixLines(content, indent, ""), indent)
+}
+
+// mdTable renders <table> as GitHub Flavored Markdown table. The first row
+// is used as header, since GFM tables always have one.
+func mdTable(table *html.Node) string {
+	var rows [][]string
+	var caption string
+	nColumns := 0
+
+	var findRows func(*html.Node)
+	findRows = func(node *html.Node) {
+		for child := dom.FirstElementChild(node); child != nil; child = dom.NextElementSibling(child) {
+			switch dom.TagName(child) {
+			case "caption":
+				caption = mdSingleLine(mdInlineChildren(child))
+
+			case "thead", "tbody", "tfoot":
+				findRows(child)
+
+			case "tr":
+				var row []string
+				for cell := dom.FirstElementChild(child); cell != nil; cell = dom.NextElementSibling(cell) {
+					if tagName := dom.TagName(cell); tagName == "td" || tagName == "th" {
+						text := mdSingleLine(mdInlineChildren(cell))
+						row = append(row, strings.ReplaceAll(text, "|", "\\|"))
+					}
+				}
+
+				if len(row) > nColumns {
+					nColumns = len(row)
+				}
+				rows = append(rows, row)
+			}
+		}
+	}
+
+	findRows(table)
+	if nColumns == 0 {
+		return caption
+	}
+
+	var lines []string
+	for i, row := range rows {
+		for len(row) < nColumns {
+			row = append(row, "")
+		}
+		lines = append(lines, "| "+strings.Join(row, " | ")+" |")
+
+		if i == 0 {
+			lines = append(lines, "|"+strings.Repeat(" --- |", nColumns))
+		}
+	}
+
+	result := strings.Join(lines, "\n")
+	if caption != "" {
+		return caption + "\n\n" + result
+	}
+	return result
+}
+
+// prefixLines adds prefix to every non empty line of text, and
+// emptyPrefix to every empty one.
+func prefixLines(text, prefix, emptyPrefix string) string {
+	if text == "" {
+		return ""
+	}
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = emptyPrefix
+		} else {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// escapeMarkdown escapes the characters that have a special meaning
+// inside of a Markdown paragraph.
+var escapeMarkdown = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	"*", `\*`,
+	"_", `\_`,
+	"[", `\[`,
+	"]", `\]`,
+	"<", `\<`,
+).Replace
+
+// escapeBlockStart escapes the start of a line that would otherwise be
+// rendered as a heading, quote, list item or thematic break.
+func escapeBlockStart(line string) string {
+	switch {
+	case line == "":
+		return line
+	case strings.ContainsRune("#>-+=", rune(line[0])):
+		return `\` + line
+	}
+
+	// Ordered list markers, e.g. "1." or "1)"
+	digits := 0
+	for digits < len(line) && digits < 9 && line[digits] >= '0' && line[digits] <= '9' {
+		digits++
+	}
+
+	if digits > 0 && digits < len(line) && (line[digits] == '.' || line[digits] == ')') &&
+		(digits+1 == len(line) || line[digits+1] == ' ') {
+		return line[:digits] + `\` + line[digits:]
+	}
+
+	return line
+}
+
+// collapseSpaces replaces each run of HTML whitespace in str with a
+// single space. Unlike strings.Fields, non-breaking spaces are kept.
+func collapseSpaces(str string) string {
+	var sb strings.Builder
+	sb.Grow(len(str))
+
+	inSpace := false
+	for _, r := range str {
+		switch r {
+		case ' ', '\t', '\n', '\r', '\f':
+			if !inSpace {
+				sb.WriteByte(' ')
+			}
+			inSpace = true
+		default:
+			sb.WriteRune(r)
+			inSpace = false
+		}
+	}
+
+	return sb.String()
+}
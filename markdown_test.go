@@ -0,0 +1,71 @@
+package readability
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-shiori/dom"
+)
+
+func Test_RenderMarkdown(t *testing.T) {
+	scenarios := map[string]string{
+		`<h1>Title</h1><p>Some <em>emphasis</em>, <strong>strong</strong> and <del>deleted</del> text.</p>`: "" +
+			"# Title\n\n" +
+			"Some *emphasis*, **strong** and ~~deleted~~ text.",
+
+		`<p>A <a href="http://example.com/" title="Example">link</a> and <img src="http://example.com/a.png" alt="an image">.</p>`: "" +
+			`A [link](http://example.com/ "Example") and ![an image](http://example.com/a.png).`,
+
+		`<ul><li>One</li><li>Two<ul><li>Nested</li></ul></li></ul><ol start="3"><li>Three</li><li>Four</li></ol>`: "" +
+			"- One\n" +
+			"- Two\n" +
+			"  - Nested\n\n" +
+			"3. Three\n" +
+			"4. Four",
+
+		`<blockquote><p>First</p><p>Second<br>line</p></blockquote>`: "" +
+			"> First\n" +
+			">\n" +
+			"> Second\\\n" +
+			"> line",
+
+		"<pre class=\"language-go\">func main() {\n\tfmt.Println(\"```\")\n}</pre>": "" +
+			"````go\n" +
+			"func main() {\n" +
+			"\tfmt.Println(\"```\")\n" +
+			"}\n" +
+			"````",
+
+		`<p>Use <code>go  test</code> to run *all* tests.</p>`: "" +
+			"Use `go test` to run \\*all\\* tests.",
+
+		`<table><tr><th>Name</th><th>Value</th></tr><tr><td>a|b</td><td>1</td></tr><tr><td>c</td></tr></table>`: "" +
+			"| Name | Value |\n" +
+			"| --- | --- |\n" +
+			"| a\\|b | 1 |\n" +
+			"| c |  |",
+
+		`<p># not a heading</p><p>1. not a list</p>`: "" +
+			"\\# not a heading\n\n" +
+			"1\\. not a list",
+
+		`<div>Loose <b>inline</b> text<p>and a paragraph</p></div>`: "" +
+			"Loose **inline** text\n\n" +
+			"and a paragraph",
+	}
+
+	for source, expected := range scenarios {
+		doc, err := dom.Parse(strings.NewReader("<div>" + source + "</div>"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body := dom.GetElementsByTagName(doc, "body")[0]
+		if result := RenderMarkdown(dom.FirstElementChild(body)); result != expected {
+			t.Errorf("\n"+
+				"source : %s\n"+
+				"want   : %q\n"+
+				"got    : %q", source, expected, result)
+		}
+	}
+}
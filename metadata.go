@@ -0,0 +1,62 @@
+package readability
+
+import "strings"
+
+// MetadataSource is where a metadata value was found.
+type MetadataSource string
+
+// The sources of metadata values.
+const (
+	// MetadataName is the content of <meta name="...">.
+	MetadataName MetadataSource = "name"
+	// MetadataProperty is the content of <meta property="...">.
+	MetadataProperty MetadataSource = "property"
+	// MetadataHTTPEquiv is the content of <meta http-equiv="...">.
+	MetadataHTTPEquiv MetadataSource = "http-equiv"
+	// MetadataJSONLD is a property of the Schema.org article in JSON-LD.
+	MetadataJSONLD MetadataSource = "json-ld"
+)
+
+// MetadataValue is a single value of a metadata field.
+type MetadataValue struct {
+	Value  string
+	Source MetadataSource
+}
+
+// Metadata is all of the metadata found in the page, keyed by normalized
+// name: lowercased, without whitespace, and with the dots in <meta name>
+// converted to colons (e.g. "dc.title" becomes "dc:title"). A field may
+// have several values, e.g. "article:tag", which are kept in the order
+// they are found: <meta> tags first in document order, then JSON-LD.
+type Metadata map[string][]MetadataValue
+
+// Get returns the first value of the field, or an empty string if the
+// field doesn't exist.
+func (m Metadata) Get(key string) string {
+	if values := m[normalizeMetadataKey(key)]; len(values) > 0 {
+		return values[0].Value
+	}
+	return ""
+}
+
+// Values returns all values of the field.
+func (m Metadata) Values(key string) []string {
+	var values []string
+	for _, value := range m[normalizeMetadataKey(key)] {
+		values = append(values, value.Value)
+	}
+	return values
+}
+
+// add appends a value to the field. Empty values are ignored.
+func (m Metadata) add(key, value string, source MetadataSource) {
+	key = normalizeMetadataKey(key)
+	if value = strings.TrimSpace(value); key != "" && value != "" {
+		m[key] = append(m[key], MetadataValue{Value: value, Source: source})
+	}
+}
+
+// normalizeMetadataKey converts key to lowercase and removes any whitespace.
+func normalizeMetadataKey(key string) string {
+	return strings.Join(strings.Fields(strings.ToLower(key)), "")
+}
@@ -0,0 +1,96 @@
+package readability
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_Metadata(t *testing.T) {
+	page := `<html><head>
+		<title>Page title</title>
+		<meta property="og:type" content="article">
+		<meta property="og:url og:see_also" content="http://fakehost/article">
+		<meta name="Twitter:Site" content="@fakehost">
+		<meta name="DC.title" content="Dublin Core title">
+		<meta property="article:section" content="Technology">
+		<meta property="article:tag" content="go">
+		<meta property="article:tag" content="html">
+		<meta name="keywords" content="go, html">
+		<meta name="robots" content="index, follow">
+		<meta name="empty" content="">
+		<script type="application/ld+json">{
+			"@context": "https://schema.org",
+			"@type": "Article",
+			"headline": "Page title, again",
+			"author": [{"name": "Jane Doe"}, {"name": "John Doe"}]
+		}</script>
+	</head><body><article><p>` + strings.Repeat("Lorem ipsum dolor sit amet. ", 30) + `</p></article></body></html>`
+
+	parser := NewParser()
+	article, err := parser.Parse(strings.NewReader(page), fakeHostURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Metadata{
+		"og:type":         {{"article", MetadataProperty}},
+		"og:url":          {{"http://fakehost/article", MetadataProperty}},
+		"og:see_also":     {{"http://fakehost/article", MetadataProperty}},
+		"twitter:site":    {{"@fakehost", MetadataName}},
+		"dc:title":        {{"Dublin Core title", MetadataName}},
+		"article:section": {{"Technology", MetadataProperty}},
+		"article:tag":     {{"go", MetadataProperty}, {"html", MetadataProperty}},
+		"keywords":        {{"go, html", MetadataName}},
+		"robots":          {{"index, follow", MetadataName}},
+		"headline":        {{"Page title, again", MetadataJSONLD}},
+		"author":          {{"Jane Doe", MetadataJSONLD}, {"John Doe", MetadataJSONLD}},
+	}
+
+	if !reflect.DeepEqual(article.Metadata, expected) {
+		t.Errorf("metadata,\nwant %v\ngot  %v", expected, article.Metadata)
+	}
+
+	if value := article.Metadata.Get("Twitter:Site"); value != "@fakehost" {
+		t.Errorf("Get, want %q got %q", "@fakehost", value)
+	}
+
+	if values := article.Metadata.Values("article:tag"); !reflect.DeepEqual(values, []string{"go", "html"}) {
+		t.Errorf("Values, want %q got %q", []string{"go", "html"}, values)
+	}
+}
+
+func Test_Metadata_jsonLd(t *testing.T) {
+	page := `<html><head>
+		<title>Page title</title>
+		<script type="application/ld+json">{
+			"@context": "https://schema.org",
+			"@type": "Article",
+			"name": "Page title",
+			"author": [{"name": "Doe, Jane"}, {"name": "John Doe"}],
+			"keywords": ["Copenhagen, Denmark", "jobs"],
+			"articleSection": ["News", "Business, Finance"]
+		}</script>
+	</head><body><article><p>` + strings.Repeat("Lorem ipsum dolor sit amet. ", 30) + `</p></article></body></html>`
+
+	parser := NewParser()
+	article, err := parser.Parse(strings.NewReader(page), fakeHostURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Metadata{
+		"name":           {{"Page title", MetadataJSONLD}},
+		"author":         {{"Doe, Jane", MetadataJSONLD}, {"John Doe", MetadataJSONLD}},
+		"keywords":       {{"Copenhagen, Denmark", MetadataJSONLD}, {"jobs", MetadataJSONLD}},
+		"articlesection": {{"News", MetadataJSONLD}, {"Business, Finance", MetadataJSONLD}},
+	}
+
+	if !reflect.DeepEqual(article.Metadata, expected) {
+		t.Errorf("metadata,\nwant %v\ngot  %v", expected, article.Metadata)
+	}
+
+	if want := []string{"Copenhagen, Denmark", "jobs"}; !reflect.DeepEqual(article.Keywords, want) {
+		t.Errorf("keywords, want %q got %q", want, article.Keywords)
+	}
+}
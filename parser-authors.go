@@ -0,0 +1,156 @@
+package readability
+
+import (
+	"strings"
+
+	"github.com/go-shiori/dom"
+	"golang.org/x/net/html"
+)
+
+// Author is one of the authors of the article.
+type Author struct {
+	Name   string
+	URL    string
+	SameAs []string
+}
+
+// getJSONLDAuthors returns the authors of the Schema.org article in
+// JSON-LD, with their URL and sameAs profile links.
+func (ps *Parser) getJSONLDAuthors(objects []map[string]interface{}, ids map[string]map[string]interface{}) []Author {
+	article := findJSONLDArticle(objects)
+	if article == nil {
+		return nil
+	}
+
+	values := resolveJSONLD(article["author"], ids)
+	if _, isArray := values.([]interface{}); !isArray {
+		values = []interface{}{values}
+	}
+
+	var authors []Author
+	for _, value := range values.([]interface{}) {
+		object, isObj := resolveJSONLD(value, ids).(map[string]interface{})
+		if !isObj {
+			continue
+		}
+
+		author := Author{
+			Name: jsonLdText(object["name"]),
+			URL:  ps.toAbsoluteURI(jsonLdURL(object["url"], ids)),
+		}
+
+		sameAs := object["sameAs"]
+		if _, isArray := sameAs.([]interface{}); !isArray {
+			sameAs = []interface{}{sameAs}
+		}
+
+		for _, item := range sameAs.([]interface{}) {
+			if url := jsonLdText(item); url != "" {
+				author.SameAs = append(author.SameAs, url)
+			}
+		}
+
+		authors = append(authors, author)
+	}
+
+	return authors
+}
+
+// getRelAuthors returns the authors linked by <a rel="author"> and
+// <link rel="author">. The name of the author is the text of the link,
+// or its title.
+func (ps *Parser) getRelAuthors() []Author {
+	var authors []Author
+	links := ps.getAllNodesWithTag(ps.doc, "a", "link")
+	ps.forEachNode(links, func(link *html.Node, _ int) {
+		if indexOf(strings.Fields(strings.ToLower(dom.GetAttribute(link, "rel"))), "author") < 0 {
+			return
+		}
+
+		name := dom.TextContent(link)
+		if strings.TrimSpace(name) == "" {
+			name = dom.GetAttribute(link, "title")
+		}
+
+		authors = append(authors, Author{
+			Name: name,
+			URL:  ps.toAbsoluteURI(strings.TrimSpace(dom.GetAttribute(link, "href"))),
+		})
+	})
+
+	return authors
+}
+
+// getBylineAuthors splits the byline found in the document into authors.
+// Anything after a separator like "|" is dropped, since that's usually the
+// date, and so are the parts that contain digits. The byline may still hold
+// a job title or a section, so its authors are only used when JSON-LD, the
+// meta tags and the rel="author" links name none.
+func (ps *Parser) getBylineAuthors(byline string) []Author {
+	if idx := strings.IndexAny(byline, "|•·—"); idx >= 0 {
+		byline = byline[:idx]
+	}
+
+	var authors []Author
+	for _, name := range RxBylineSeparator.Split(byline, -1) {
+		name = cleanAuthorName(name)
+		if name == "" || strings.ContainsAny(name, "0123456789") || wordCount(name) > 5 {
+			continue
+		}
+		authors = append(authors, Author{Name: name})
+	}
+
+	return authors
+}
+
+// mergeAuthors merges the authors from each source, in order. Authors are
+// deduplicated by their name ignoring case, or by their URL (e.g. a profile
+// link named "View my profile"). Authors without a name that don't match
+// anyone are dropped.
+func mergeAuthors(sources ...[]Author) []Author {
+	var authors []Author
+
+	findAuthor := func(author Author) int {
+		for i, existing := range authors {
+			if author.Name != "" && strings.EqualFold(existing.Name, author.Name) {
+				return i
+			}
+			if author.URL != "" && existing.URL == author.URL {
+				return i
+			}
+		}
+		return -1
+	}
+
+	for _, source := range sources {
+		for _, author := range source {
+			author.Name = cleanAuthorName(author.Name)
+
+			idx := findAuthor(author)
+			if idx < 0 {
+				if author.Name != "" {
+					authors = append(authors, author)
+				}
+				continue
+			}
+
+			existing := &authors[idx]
+			existing.URL = strOr(existing.URL, author.URL)
+			for _, url := range author.SameAs {
+				if indexOf(existing.SameAs, url) < 0 {
+					existing.SameAs = append(existing.SameAs, url)
+				}
+			}
+		}
+	}
+
+	return authors
+}
+
+// cleanAuthorName normalizes the whitespace in name and strips prefixes
+// like "By" and "Written by".
+func cleanAuthorName(name string) string {
+	name = strings.Join(strings.Fields(name), " ")
+	name = RxBylinePrefix.ReplaceAllString(name, "")
+	return strings.Trim(name, " ,;:：")
+}
@@ -0,0 +1,103 @@
+package readability
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_Authors(t *testing.T) {
+	page := `<html><head>
+		<meta name="author" content="jane doe">
+		<meta name="author" content="Written by Max Mustermann">
+		<script type="application/ld+json">{
+			"@context": "https://schema.org",
+			"@type": "NewsArticle",
+			"author": [
+				{"@type": "Person", "name": "Jane Doe", "url": "/authors/jane", "sameAs": ["https://twitter.com/janedoe", "https://github.com/janedoe"]},
+				{"@type": "Person", "name": "By John Smith", "sameAs": "https://twitter.com/johnsmith"}
+			]
+		}</script>
+	</head><body><article>
+		<p class="byline">By <a rel="author" href="/authors/john">John Smith</a> | March 3, 2020</p>
+		<p><a rel="author" href="/authors/jane">View profile</a></p>
+		<p>` + strings.Repeat("Lorem ipsum dolor sit amet. ", 30) + `</p>
+	</article></body></html>`
+
+	parser := NewParser()
+	article, err := parser.Parse(strings.NewReader(page), fakeHostURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Author{{
+		Name:   "Jane Doe",
+		URL:    "http://fakehost/authors/jane",
+		SameAs: []string{"https://twitter.com/janedoe", "https://github.com/janedoe"},
+	}, {
+		Name:   "John Smith",
+		URL:    "http://fakehost/authors/john",
+		SameAs: []string{"https://twitter.com/johnsmith"},
+	}, {
+		Name: "Max Mustermann",
+	}}
+
+	if !reflect.DeepEqual(article.Authors, expected) {
+		t.Errorf("authors,\nwant %+v\ngot  %+v", expected, article.Authors)
+	}
+}
+
+func Test_Authors_byline(t *testing.T) {
+	paragraph := "<p>" + strings.Repeat("Lorem ipsum dolor sit amet. ", 30) + "</p>"
+	scenarios := map[string]struct {
+		head     string
+		expected []Author
+	}{
+		// The byline is only a fallback, so its extra names are dropped
+		"with metadata": {
+			head:     `<meta name="author" content="Jane Doe">`,
+			expected: []Author{{Name: "Jane Doe"}},
+		},
+		"without metadata": {
+			expected: []Author{{Name: "JANE DOE"}, {Name: "Senior Technologist"}},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		page := `<html><head>` + scenario.head + `</head><body><article>
+			<p class="byline">By JANE DOE, Senior Technologist</p>` + paragraph + `
+		</article></body></html>`
+
+		parser := NewParser()
+		article, err := parser.Parse(strings.NewReader(page), fakeHostURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(article.Authors, scenario.expected) {
+			t.Errorf("%s, want %+v got %+v", name, scenario.expected, article.Authors)
+		}
+	}
+}
+
+func Test_getBylineAuthors(t *testing.T) {
+	scenarios := map[string][]string{
+		"By Jane Doe and John Smith | March 3, 2020": {"Jane Doe", "John Smith"},
+		"Von Max Mustermann und Erika Musterfrau":    {"Max Mustermann", "Erika Musterfrau"},
+		"Written by: Ann Lee, 2 hours ago":           {"Ann Lee"},
+		"BY JANE DOE & JOHN SMITH":                   {"JANE DOE", "JOHN SMITH"},
+		"Bystander":                                  {"Bystander"},
+	}
+
+	parser := NewParser()
+	for byline, expected := range scenarios {
+		var names []string
+		for _, author := range parser.getBylineAuthors(byline) {
+			names = append(names, author.Name)
+		}
+
+		if !reflect.DeepEqual(names, expected) {
+			t.Errorf("byline %q, want %q got %q", byline, expected, names)
+		}
+	}
+}
@@ -11,8 +11,13 @@ import (
 
 // Check checks whether the input is readable without parsing the whole thing.
 func (ps *Parser) Check(input io.Reader) bool {
-	// Parse input
-	doc, err := dom.Parse(input)
+	// Decode and parse input
+	r, _, err := decodeInput(input, "")
+	if err != nil {
+		return false
+	}
+
+	doc, err := html.Parse(r)
 	if err != nil {
 		return false
 	}
@@ -52,14 +57,14 @@ func (ps *Parser) CheckDocument(doc *html.Node) bool {
 	// This is a little cheeky, we use the accumulator 'score' to decide what
 	// to return from this callback.
 	score := float64(0)
+	rules := ps.Scoring.withDefaults()
 	return ps.someNode(nodes, func(node *html.Node) bool {
 		if !ps.isProbablyVisible(node) {
 			return false
 		}
 
 		matchString := dom.ClassName(node) + " " + dom.ID(node)
-		if RxUnlikelyCandidates.MatchString(matchString) &&
-			!RxOkMaybeItsACandidate.MatchString(matchString) {
+		if rules.isUnlikelyCandidate(matchString) {
 			return false
 		}
 
@@ -0,0 +1,231 @@
+package readability
+
+import (
+	shtml "html"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-shiori/dom"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// JobPosting is a job listing, as described by a Schema.org JobPosting
+// object in the JSON-LD of the page.
+type JobPosting struct {
+	Title              string
+	Description        string
+	Identifier         string
+	HiringOrganization JobOrganization
+	Locations          []JobLocation
+	Remote             bool
+	EmploymentType     []string
+	DatePosted         *time.Time
+	ValidThrough       *time.Time
+	BaseSalary         *JobSalary
+}
+
+// JobOrganization is the organization that offers the job.
+type JobOrganization struct {
+	Name string
+	URL  string
+	Logo string
+}
+
+// JobLocation is the place where the job is located.
+type JobLocation struct {
+	Name          string
+	StreetAddress string
+	Locality      string
+	Region        string
+	PostalCode    string
+	Country       string
+}
+
+// JobSalary is the base salary of the job. Value is used for an exact
+// amount, while MinValue and MaxValue are used for a range. UnitText is
+// the period of the salary, e.g. "HOUR", "MONTH" or "YEAR".
+type JobSalary struct {
+	Currency string
+	Value    float64
+	MinValue float64
+	MaxValue float64
+	UnitText string
+}
+
+// getJSONLDJobPosting returns the first Schema.org JobPosting in objects,
+// or nil if there is none.
+func (ps *Parser) getJSONLDJobPosting(objects []map[string]interface{}, ids map[string]map[string]interface{}) *JobPosting {
+	for _, object := range objects {
+		if jsonLdHasType(object, func(t string) bool { return t == "JobPosting" }) {
+			return ps.getJobPosting(object, ids)
+		}
+	}
+	return nil
+}
+
+// getJobPosting extracts the data of a Schema.org JobPosting object.
+func (ps *Parser) getJobPosting(parsed map[string]interface{}, ids map[string]map[string]interface{}) *JobPosting {
+	jobPosting := &JobPosting{
+		Title:          strOr(jsonLdText(parsed["title"]), jsonLdText(parsed["name"])),
+		Description:    jsonLdText(parsed["description"]),
+		EmploymentType: jsonLdStrings(parsed["employmentType"]),
+	}
+
+	// Some sites put the description in JSON-LD as escaped HTML, so it
+	// must be unescaped once more before it can be used as HTML.
+	if !strings.Contains(jobPosting.Description, "<") && strings.Contains(jobPosting.Description, "&lt;") {
+		jobPosting.Description = shtml.UnescapeString(jobPosting.Description)
+	}
+
+	// Identifier, either as plain value or as PropertyValue
+	switch val := resolveJSONLD(parsed["identifier"], ids).(type) {
+	case map[string]interface{}:
+		jobPosting.Identifier = jsonLdValue(val["value"])
+	default:
+		jobPosting.Identifier = jsonLdValue(val)
+	}
+
+	// Hiring organization
+	switch val := resolveJSONLD(parsed["hiringOrganization"], ids).(type) {
+	case string:
+		jobPosting.HiringOrganization.Name = strings.TrimSpace(val)
+	case map[string]interface{}:
+		jobPosting.HiringOrganization = JobOrganization{
+			Name: jsonLdText(val["name"]),
+			URL:  strOr(jsonLdURL(val["url"], ids), jsonLdURL(val["sameAs"], ids)),
+			Logo: jsonLdURL(val["logo"], ids, "url", "contentUrl"),
+		}
+	}
+
+	// Locations, which may be a single Place or an array of them
+	locations := resolveJSONLD(parsed["jobLocation"], ids)
+	if _, isArray := locations.([]interface{}); !isArray {
+		locations = []interface{}{locations}
+	}
+
+	for _, location := range locations.([]interface{}) {
+		if place, isObj := resolveJSONLD(location, ids).(map[string]interface{}); isObj {
+			jobPosting.Locations = append(jobPosting.Locations, jsonLdJobLocation(place, ids))
+		}
+	}
+
+	for _, locationType := range jsonLdStrings(parsed["jobLocationType"]) {
+		if strings.EqualFold(locationType, "TELECOMMUTE") {
+			jobPosting.Remote = true
+		}
+	}
+
+	// Dates
+	if datePosted := jsonLdText(parsed["datePosted"]); datePosted != "" {
+		jobPosting.DatePosted = ps.getParsedDate(datePosted)
+	}
+
+	if validThrough := jsonLdText(parsed["validThrough"]); validThrough != "" {
+		jobPosting.ValidThrough = ps.getParsedDate(validThrough)
+	}
+
+	// Salary, as MonetaryAmount whose value is either a plain number or
+	// a QuantitativeValue
+	if salary, isObj := resolveJSONLD(parsed["baseSalary"], ids).(map[string]interface{}); isObj {
+		jobPosting.BaseSalary = &JobSalary{
+			Currency: jsonLdText(salary["currency"]),
+			UnitText: jsonLdText(salary["unitText"]),
+		}
+
+		switch val := resolveJSONLD(salary["value"], ids).(type) {
+		case map[string]interface{}:
+			jobPosting.BaseSalary.Value, _ = jsonLdNumber(val["value"])
+			jobPosting.BaseSalary.MinValue, _ = jsonLdNumber(val["minValue"])
+			jobPosting.BaseSalary.MaxValue, _ = jsonLdNumber(val["maxValue"])
+			jobPosting.BaseSalary.UnitText = strOr(jsonLdText(val["unitText"]), jobPosting.BaseSalary.UnitText)
+		default:
+			jobPosting.BaseSalary.Value, _ = jsonLdNumber(val)
+		}
+	}
+
+	return jobPosting
+}
+
+// jsonLdJobLocation extracts the address of a Schema.org Place object.
+func jsonLdJobLocation(place map[string]interface{}, ids map[string]map[string]interface{}) JobLocation {
+	location := JobLocation{Name: jsonLdText(place["name"])}
+
+	switch address := resolveJSONLD(place["address"], ids).(type) {
+	case string:
+		location.StreetAddress = strings.TrimSpace(address)
+
+	case map[string]interface{}:
+		location.StreetAddress = jsonLdText(address["streetAddress"])
+		location.Locality = jsonLdText(address["addressLocality"])
+		location.Region = jsonLdText(address["addressRegion"])
+		location.PostalCode = jsonLdValue(address["postalCode"])
+
+		// The country may be a country code or a Country object
+		switch country := resolveJSONLD(address["addressCountry"], ids).(type) {
+		case string:
+			location.Country = strings.TrimSpace(country)
+		case map[string]interface{}:
+			location.Country = jsonLdText(country["name"])
+		}
+	}
+
+	return location
+}
+
+// jsonLdValue returns value as string. Unlike jsonLdText, numbers are
+// converted as well.
+func jsonLdValue(value interface{}) string {
+	if number, isNumber := value.(float64); isNumber {
+		return strconv.FormatFloat(number, 'f', -1, 64)
+	}
+	return jsonLdText(value)
+}
+
+// jsonLdNumber returns value as number. The value may be a number or a
+// string that contains a number.
+func jsonLdNumber(value interface{}) (float64, bool) {
+	switch val := value.(type) {
+	case float64:
+		return val, true
+	case string:
+		number, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+		return number, err == nil
+	}
+	return 0, false
+}
+
+// getJobPostingContent creates the article content from the description
+// of a job posting, using the same structure as grabArticle.
+func (ps *Parser) getJobPostingContent(description string) *html.Node {
+	page := dom.CreateElement("div")
+	dom.SetAttribute(page, "id", "readability-page-1")
+	dom.SetAttribute(page, "class", "page")
+
+	context := &html.Node{Type: html.ElementNode, Data: "div", DataAtom: atom.Div}
+	nodes, err := html.ParseFragment(strings.NewReader(description), context)
+	if err != nil {
+		ps.logDebug("failed to parse job description", "error", err)
+		return nil
+	}
+
+	for _, node := range nodes {
+		dom.AppendChild(page, node)
+	}
+
+	// Plain text description is put inside a paragraph
+	if len(dom.Children(page)) == 0 {
+		p := dom.CreateElement("p")
+		for page.FirstChild != nil {
+			dom.AppendChild(p, page.FirstChild)
+		}
+		dom.AppendChild(page, p)
+	}
+
+	articleContent := dom.CreateElement("div")
+	dom.AppendChild(articleContent, page)
+	ps.removeScripts(articleContent)
+	ps.cleanStyles(articleContent)
+	return articleContent
+}
@@ -0,0 +1,85 @@
+package readability
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const jobPostingTestPage = `<html><head><title>Senior Gopher - Example Inc.</title>
+<script type="application/ld+json">{
+	"@context": "https://schema.org",
+	"@graph": [
+		{"@type": "Organization", "@id": "#org", "name": "Example Inc.", "sameAs": "https://example.com", "logo": {"@type": "ImageObject", "url": "https://example.com/logo.png"}},
+		{
+			"@type": "JobPosting",
+			"title": "Senior Gopher",
+			"description": "&lt;p&gt;We are looking for a gopher to help us build a fast and reliable readability parser, which is used by millions of readers every day.&lt;/p&gt;&lt;ul&gt;&lt;li&gt;Go&lt;/li&gt;&lt;li&gt;HTML&lt;/li&gt;&lt;/ul&gt;",
+			"identifier": {"@type": "PropertyValue", "name": "Example Inc.", "value": 1234},
+			"hiringOrganization": {"@id": "#org"},
+			"jobLocation": [
+				{"@type": "Place", "address": {"@type": "PostalAddress", "streetAddress": "1 Main St", "addressLocality": "Copenhagen", "postalCode": "1000", "addressCountry": "DK"}},
+				{"@type": "Place", "address": {"@type": "PostalAddress", "addressLocality": "Aarhus", "addressCountry": {"@type": "Country", "name": "Denmark"}}}
+			],
+			"jobLocationType": "TELECOMMUTE",
+			"employmentType": ["FULL_TIME", "CONTRACTOR"],
+			"datePosted": "2024-01-15",
+			"validThrough": "2024-02-15T00:00",
+			"baseSalary": {"@type": "MonetaryAmount", "currency": "DKK", "value": {"@type": "QuantitativeValue", "minValue": 50000, "maxValue": "60000", "unitText": "MONTH"}}
+		}
+	]
+}</script></head>
+<body><div><p>Apply now!</p></div></body></html>`
+
+func Test_getJSONLDJobPosting(t *testing.T) {
+	parser := NewParser()
+	article, _ := parser.Parse(strings.NewReader(jobPostingTestPage), fakeHostURL)
+	if article.JobPosting != nil {
+		t.Errorf("job posting should only be extracted when enabled")
+	}
+
+	parser.ExtractJobPosting = true
+	// The description is too short to pass CharThresholds, which is fine
+	article, err := parser.Parse(strings.NewReader(jobPostingTestPage), fakeHostURL)
+	if err != nil && !errors.Is(err, ErrNoContent) {
+		t.Fatal(err)
+	}
+
+	datePosted := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	validThrough := time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC)
+	want := &JobPosting{
+		Title:       "Senior Gopher",
+		Description: "<p>We are looking for a gopher to help us build a fast and reliable readability parser, which is used by millions of readers every day.</p><ul><li>Go</li><li>HTML</li></ul>",
+		Identifier:  "1234",
+		HiringOrganization: JobOrganization{
+			Name: "Example Inc.",
+			URL:  "https://example.com",
+			Logo: "https://example.com/logo.png",
+		},
+		Locations: []JobLocation{
+			{StreetAddress: "1 Main St", Locality: "Copenhagen", PostalCode: "1000", Country: "DK"},
+			{Locality: "Aarhus", Country: "Denmark"},
+		},
+		Remote:         true,
+		EmploymentType: []string{"FULL_TIME", "CONTRACTOR"},
+		DatePosted:     &datePosted,
+		ValidThrough:   &validThrough,
+		BaseSalary: &JobSalary{
+			Currency: "DKK",
+			MinValue: 50000,
+			MaxValue: 60000,
+			UnitText: "MONTH",
+		},
+	}
+
+	if !reflect.DeepEqual(article.JobPosting, want) {
+		t.Errorf("job posting,\nwant %+v\ngot  %+v", want, article.JobPosting)
+	}
+
+	// The page body is weak, so the description is used as content
+	if !strings.Contains(article.Content, "<li>HTML</li>") || strings.Contains(article.Content, "Apply now") {
+		t.Errorf("content should be the job description, got %q", article.Content)
+	}
+}
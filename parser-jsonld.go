@@ -0,0 +1,381 @@
+package readability
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/go-shiori/dom"
+	"golang.org/x/net/html"
+)
+
+// jsonLdNestedKeys are the properties whose values are searched for more
+// Schema.org objects, besides the top level of each JSON-LD script.
+var jsonLdNestedKeys = []string{"@graph", "mainEntity"}
+
+// getJSONLD try to extract metadata from JSON-LD object. Every object
+// found by getJSONLDObjects is searched, and the first Schema.org object
+// of type Article or its subtypes is used. Besides the metadata used by
+// the parser, it returns the raw values keyed by their Schema.org property.
+func (ps *Parser) getJSONLD(objects []map[string]interface{}, ids map[string]map[string]interface{}) (map[string]string, Metadata) {
+	if article := findJSONLDArticle(objects); article != nil {
+		return ps.getJSONLDArticle(article, ids)
+	}
+	return nil, nil
+}
+
+// findJSONLDArticle returns the first object of type Article or its
+// subtypes, or nil if there is none.
+func findJSONLDArticle(objects []map[string]interface{}) map[string]interface{} {
+	for _, object := range objects {
+		if jsonLdHasType(object, func(t string) bool { return RxJsonLdArticleTypes.MatchString(t) }) {
+			return object
+		}
+	}
+	return nil
+}
+
+// getJSONLDObjects decodes all JSON-LD scripts in the document and returns
+// the typed Schema.org objects inside them, in document order. Top level
+// arrays and nested @graph lists are flattened.
+func (ps *Parser) getJSONLDObjects() []map[string]interface{} {
+	var objects []map[string]interface{}
+
+	scripts := dom.QuerySelectorAll(ps.doc, `script[type="application/ld+json"]`)
+	ps.forEachNode(scripts, func(jsonLdElement *html.Node, _ int) {
+		// Strip CDATA markers if present
+		content := RxCDATA.ReplaceAllString(dom.TextContent(jsonLdElement), "")
+
+		// Decode JSON
+		var parsed interface{}
+		err := json.Unmarshal([]byte(content), &parsed)
+		if err != nil {
+			ps.logDebug("error while decoding json", "error", err)
+			return
+		}
+
+		objects = appendJSONLDObjects(objects, parsed, false)
+	})
+
+	return objects
+}
+
+// appendJSONLDObjects appends the typed objects in value to objects. An
+// object is only used if it, or one of its ancestors, has a Schema.org
+// @context.
+func appendJSONLDObjects(objects []map[string]interface{}, value interface{}, isSchemaOrg bool) []map[string]interface{} {
+	switch val := value.(type) {
+	case []interface{}:
+		for _, item := range val {
+			objects = appendJSONLDObjects(objects, item, isSchemaOrg)
+		}
+
+	case map[string]interface{}:
+		if context, exist := val["@context"]; exist {
+			isSchemaOrg = isSchemaOrgContext(context)
+		}
+
+		if !isSchemaOrg {
+			return objects
+		}
+
+		if _, typeExist := val["@type"]; typeExist {
+			objects = append(objects, val)
+		}
+
+		for _, key := range jsonLdNestedKeys {
+			if nested, exist := val[key]; exist {
+				objects = appendJSONLDObjects(objects, nested, true)
+			}
+		}
+	}
+
+	return objects
+}
+
+// isSchemaOrgContext checks if a JSON-LD @context refers to Schema.org,
+// either as a plain string, inside an array or as the @vocab of an object.
+func isSchemaOrgContext(context interface{}) bool {
+	switch val := context.(type) {
+	case string:
+		return RxSchemaOrg.MatchString(val)
+
+	case []interface{}:
+		for _, item := range val {
+			if isSchemaOrgContext(item) {
+				return true
+			}
+		}
+
+	case map[string]interface{}:
+		vocab, isString := val["@vocab"].(string)
+		return isString && RxSchemaOrg.MatchString(vocab)
+	}
+
+	return false
+}
+
+// jsonLdHasType checks if any of the @type of object passes the check. The
+// type may be a string or an array of strings, and may be prefixed by the
+// Schema.org URL (e.g. "http://schema.org/NewsArticle").
+func jsonLdHasType(object map[string]interface{}, check func(string) bool) bool {
+	var types []interface{}
+	switch val := object["@type"].(type) {
+	case string:
+		types = []interface{}{val}
+	case []interface{}:
+		types = val
+	}
+
+	for _, item := range types {
+		if strType, isString := item.(string); isString {
+			strType = strType[strings.LastIndexAny(strType, "/:")+1:]
+			if check(strType) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// jsonLdIDs maps the @id of objects to the object itself, so references
+// like {"@id": "#author"} can be resolved.
+func jsonLdIDs(objects []map[string]interface{}) map[string]map[string]interface{} {
+	ids := make(map[string]map[string]interface{})
+	for _, object := range objects {
+		if id, isString := object["@id"].(string); isString && id != "" {
+			if _, exist := ids[id]; !exist {
+				ids[id] = object
+			}
+		}
+	}
+	return ids
+}
+
+// resolveJSONLD returns the object referenced by value if it's a bare
+// {"@id": ...} reference, or value itself otherwise.
+func resolveJSONLD(value interface{}, ids map[string]map[string]interface{}) interface{} {
+	object, isObj := value.(map[string]interface{})
+	if !isObj || len(object) != 1 {
+		return value
+	}
+
+	id, isString := object["@id"].(string)
+	if referenced, exist := ids[id]; isString && exist {
+		return referenced
+	}
+
+	return value
+}
+
+// getJSONLDArticle extracts the metadata of a Schema.org Article object,
+// along with the raw values keyed by the property they are read from. The
+// lists, like author or keywords, have one raw value per item.
+func (ps *Parser) getJSONLDArticle(parsed map[string]interface{}, ids map[string]map[string]interface{}) (map[string]string, Metadata) {
+	metadata := make(map[string]string)
+	raw := make(Metadata)
+
+	// Title
+	name, nameIsString := parsed["name"].(string)
+	headline, headlineIsString := parsed["headline"].(string)
+
+	if nameIsString && headlineIsString && name != headline {
+		// We have both name and headline element in the JSON-LD. They should both be the same
+		// but some websites like aktualne.cz put their own name into "name" and the article
+		// title to "headline" which confuses Readability. So we try to check if either "name"
+		// or "headline" closely matches the html title, and if so, use that one. If not, then
+		// we use "name" by default.
+		title := ps.getArticleTitle()
+		nameMatches := ps.textSimilarity(name, title) > 0.75
+		headlineMatches := ps.textSimilarity(headline, title) > 0.75
+
+		if headlineMatches && !nameMatches {
+			metadata["title"] = headline
+			raw.add("headline", headline, MetadataJSONLD)
+		} else {
+			metadata["title"] = name
+			raw.add("name", name, MetadataJSONLD)
+		}
+	} else if name, isString := parsed["name"].(string); isString {
+		metadata["title"] = strings.TrimSpace(name)
+		raw.add("name", name, MetadataJSONLD)
+	} else if headline, isString := parsed["headline"].(string); isString {
+		metadata["title"] = strings.TrimSpace(headline)
+		raw.add("headline", headline, MetadataJSONLD)
+	}
+
+	// Author
+	if authors := jsonLdNames(parsed["author"], ids); len(authors) > 0 {
+		metadata["byline"] = strings.Join(authors, ", ")
+		for _, author := range authors {
+			raw.add("author", author, MetadataJSONLD)
+		}
+	}
+
+	// Description
+	if description, isString := parsed["description"].(string); isString {
+		metadata["excerpt"] = strings.TrimSpace(description)
+		raw.add("description", description, MetadataJSONLD)
+	}
+
+	// Publisher
+	if publishers := jsonLdNames(parsed["publisher"], ids); len(publishers) > 0 {
+		metadata["siteName"] = publishers[0]
+		raw.add("publisher", publishers[0], MetadataJSONLD)
+	}
+
+	// DatePublished and DateModified
+	if datePublished, isString := parsed["datePublished"].(string); isString {
+		metadata["datePublished"] = datePublished
+		raw.add("datePublished", datePublished, MetadataJSONLD)
+	}
+
+	if dateModified, isString := parsed["dateModified"].(string); isString {
+		metadata["dateModified"] = dateModified
+		raw.add("dateModified", dateModified, MetadataJSONLD)
+	}
+
+	// Image
+	if image := jsonLdURL(parsed["image"], ids, "url", "contentUrl"); image != "" {
+		metadata["image"] = image
+		raw.add("image", image, MetadataJSONLD)
+	}
+
+	// Keywords and section, which may be either a comma separated string
+	// or an array of strings. The keywords are only kept as raw values,
+	// since a keyword may contain a comma itself.
+	for _, keyword := range jsonLdStrings(parsed["keywords"]) {
+		raw.add("keywords", keyword, MetadataJSONLD)
+	}
+
+	if sections := jsonLdStrings(parsed["articleSection"]); len(sections) > 0 {
+		metadata["section"] = strings.Join(sections, ", ")
+		for _, section := range sections {
+			raw.add("articleSection", section, MetadataJSONLD)
+		}
+	}
+
+	// Language, either as IETF language tag or as Language object
+	switch val := resolveJSONLD(parsed["inLanguage"], ids).(type) {
+	case string:
+		metadata["language"] = strings.TrimSpace(val)
+	case map[string]interface{}:
+		metadata["language"] = jsonLdText(val["alternateName"])
+	}
+
+	// Word count
+	switch val := parsed["wordCount"].(type) {
+	case float64:
+		metadata["wordCount"] = strconv.Itoa(int(val))
+	case string:
+		if _, err := strconv.Atoi(strings.TrimSpace(val)); err == nil {
+			metadata["wordCount"] = strings.TrimSpace(val)
+		}
+	}
+
+	// Paywall
+	switch val := parsed["isAccessibleForFree"].(type) {
+	case bool:
+		metadata["accessibleForFree"] = strconv.FormatBool(val)
+	case string:
+		if isFree, err := strconv.ParseBool(strings.TrimSpace(val)); err == nil {
+			metadata["accessibleForFree"] = strconv.FormatBool(isFree)
+		}
+	}
+
+	// Main entity of page, either as URL or as WebPage object
+	if mainEntityOfPage := jsonLdURL(parsed["mainEntityOfPage"], ids, "@id", "url"); mainEntityOfPage != "" {
+		metadata["mainEntityOfPage"] = mainEntityOfPage
+	}
+
+	raw.add("inLanguage", metadata["language"], MetadataJSONLD)
+	raw.add("wordCount", metadata["wordCount"], MetadataJSONLD)
+	raw.add("isAccessibleForFree", metadata["accessibleForFree"], MetadataJSONLD)
+	raw.add("mainEntityOfPage", metadata["mainEntityOfPage"], MetadataJSONLD)
+
+	return metadata, raw
+}
+
+// jsonLdText returns value as trimmed string, or an empty string if value
+// isn't a string.
+func jsonLdText(value interface{}) string {
+	str, _ := value.(string)
+	return strings.TrimSpace(str)
+}
+
+// jsonLdNames returns the names in value, which may be an object with a
+// name (e.g. Person or Organization), a reference to such object, or an
+// array of any of those. Like Readability.js, plain strings are ignored
+// since they're often user names rather than real names.
+func jsonLdNames(value interface{}, ids map[string]map[string]interface{}) []string {
+	var names []string
+	switch val := resolveJSONLD(value, ids).(type) {
+	case map[string]interface{}:
+		if name := jsonLdText(val["name"]); name != "" {
+			names = append(names, name)
+		}
+
+	case []interface{}:
+		for _, item := range val {
+			if _, isArray := item.([]interface{}); !isArray {
+				names = append(names, jsonLdNames(item, ids)...)
+			}
+		}
+	}
+	return names
+}
+
+// jsonLdURL returns the first URL in value, which may be a plain URL, an
+// object that has the URL in one of urlKeys (e.g. ImageObject), a reference
+// to such object, or an array of any of those.
+func jsonLdURL(value interface{}, ids map[string]map[string]interface{}, urlKeys ...string) string {
+	switch val := resolveJSONLD(value, ids).(type) {
+	case string:
+		return strings.TrimSpace(val)
+
+	case map[string]interface{}:
+		for _, key := range urlKeys {
+			if url := jsonLdText(val[key]); url != "" {
+				return url
+			}
+		}
+
+	case []interface{}:
+		for _, item := range val {
+			if _, isArray := item.([]interface{}); isArray {
+				continue
+			}
+
+			if url := jsonLdURL(item, ids, urlKeys...); url != "" {
+				return url
+			}
+		}
+	}
+
+	return ""
+}
+
+// jsonLdStrings returns the strings in value, which may be a comma separated
+// string or an array of strings.
+func jsonLdStrings(value interface{}) []string {
+	var items []string
+	switch val := value.(type) {
+	case string:
+		items = strings.Split(val, ",")
+	case []interface{}:
+		for _, item := range val {
+			if str, isString := item.(string); isString {
+				items = append(items, str)
+			}
+		}
+	}
+
+	var result []string
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -0,0 +1,127 @@
+package readability
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func parseJSONLDTestPage(t *testing.T, scripts ...string) Article {
+	var sb strings.Builder
+	sb.WriteString("<html><head><title>Page title</title>")
+	for _, script := range scripts {
+		sb.WriteString(`<script type="application/ld+json">` + script + `</script>`)
+	}
+	sb.WriteString("</head><body><article><p>" + strings.Repeat("Lorem ipsum dolor sit amet. ", 30) + "</p></article></body></html>")
+
+	parser := NewParser()
+	article, err := parser.Parse(strings.NewReader(sb.String()), fakeHostURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return article
+}
+
+func Test_getJSONLD(t *testing.T) {
+	t.Run("multiple scripts, top level array and @type array", func(t1 *testing.T) {
+		article := parseJSONLDTestPage(t1,
+			`{"@context": "https://schema.org", "@type": "WebSite", "name": "Site"}`,
+			`[
+				{"@context": "https://schema.org", "@type": "BreadcrumbList"},
+				{"@context": ["https://schema.org", {"@language": "en"}], "@type": ["NewsArticle", "Article"],
+				 "headline": "Page title", "author": "ignored"}
+			]`)
+
+		if article.Title != "Page title" {
+			t1.Errorf("title, want %q got %q", "Page title", article.Title)
+		}
+		if article.Byline != "" {
+			t1.Errorf("byline, want %q got %q", "", article.Byline)
+		}
+	})
+
+	t.Run("nested @graph with references", func(t1 *testing.T) {
+		article := parseJSONLDTestPage(t1, `{
+			"@context": {"@vocab": "http://schema.org/"},
+			"@graph": [
+				{"@type": "Organization", "@id": "#publisher", "name": "Publisher"},
+				{"@graph": [
+					{"@type": "Person", "@id": "#jane", "name": "Jane Doe"},
+					{"@type": "http://schema.org/BlogPosting", "name": "Page title",
+					 "author": [{"@id": "#jane"}, {"@type": "Person", "name": "John Doe"}],
+					 "publisher": {"@id": "#publisher"}}
+				]}
+			]
+		}`)
+
+		if article.Byline != "Jane Doe, John Doe" {
+			t1.Errorf("byline, want %q got %q", "Jane Doe, John Doe", article.Byline)
+		}
+		if article.SiteName != "Publisher" {
+			t1.Errorf("site name, want %q got %q", "Publisher", article.SiteName)
+		}
+	})
+
+	t.Run("article inside mainEntity", func(t1 *testing.T) {
+		article := parseJSONLDTestPage(t1, `{
+			"@context": "https://schema.org", "@type": "WebPage",
+			"mainEntity": {"@type": "Article", "name": "Page title", "author": {"name": "Jane Doe"}}
+		}`)
+
+		if article.Byline != "Jane Doe" {
+			t1.Errorf("byline, want %q got %q", "Jane Doe", article.Byline)
+		}
+	})
+
+	t.Run("other context is ignored", func(t1 *testing.T) {
+		article := parseJSONLDTestPage(t1,
+			`{"@context": "https://example.com", "@type": "Article", "name": "Page title", "author": {"name": "Jane Doe"}}`)
+
+		if article.Byline != "" {
+			t1.Errorf("byline, want %q got %q", "", article.Byline)
+		}
+	})
+
+	t.Run("extra fields", func(t1 *testing.T) {
+		article := parseJSONLDTestPage(t1, `{
+			"@context": "https://schema.org",
+			"@type": "NewsArticle",
+			"name": "Page title",
+			"dateModified": "2024-03-02T10:00:00Z",
+			"image": [{"@type": "ImageObject", "url": "http://fakehost/image.jpg"}, "http://fakehost/other.jpg"],
+			"keywords": ["go", " readability ", ""],
+			"articleSection": "Technology",
+			"inLanguage": {"@type": "Language", "name": "English", "alternateName": "en"},
+			"wordCount": "1234",
+			"isAccessibleForFree": "False",
+			"mainEntityOfPage": {"@type": "WebPage", "@id": "http://fakehost/article"}
+		}`)
+
+		wantModified := time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC)
+		if article.ModifiedTime == nil || !article.ModifiedTime.Equal(wantModified) {
+			t1.Errorf("modified time, want %v got %v", wantModified, article.ModifiedTime)
+		}
+		if article.Image != "http://fakehost/image.jpg" {
+			t1.Errorf("image, want %q got %q", "http://fakehost/image.jpg", article.Image)
+		}
+		if want := []string{"go", "readability"}; !reflect.DeepEqual(article.Keywords, want) {
+			t1.Errorf("keywords, want %q got %q", want, article.Keywords)
+		}
+		if article.Section != "Technology" {
+			t1.Errorf("section, want %q got %q", "Technology", article.Section)
+		}
+		if article.Language != "en" {
+			t1.Errorf("language, want %q got %q", "en", article.Language)
+		}
+		if article.WordCount != 1234 {
+			t1.Errorf("word count, want %d got %d", 1234, article.WordCount)
+		}
+		if article.AccessibleForFree == nil || *article.AccessibleForFree {
+			t1.Errorf("accessible for free, want false got %v", article.AccessibleForFree)
+		}
+		if article.MainEntityOfPage != "http://fakehost/article" {
+			t1.Errorf("main entity of page, want %q got %q", "http://fakehost/article", article.MainEntityOfPage)
+		}
+	})
+}
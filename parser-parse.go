@@ -1,9 +1,13 @@
 package readability
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	nurl "net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,38 +18,166 @@ import (
 
 // Parse parses a reader and find the main readable content.
 func (ps *Parser) Parse(input io.Reader, pageURL *nurl.URL) (Article, error) {
+	return ps.ParseContext(context.Background(), input, pageURL)
+}
+
+// ParseContext is like Parse, but stops the extraction as soon as ctx is
+// cancelled. In that case the returned error is ctx.Err().
+func (ps *Parser) ParseContext(ctx context.Context, input io.Reader, pageURL *nurl.URL) (Article, error) {
+	return ps.parseInput(ctx, input, pageURL, "", "")
+}
+
+// parseInput transcodes the input to UTF-8 before parsing it. The charset in
+// contentType, if any, takes precedence over the one declared in the document.
+// The contentLanguage is used to resolve the language of the article.
+func (ps *Parser) parseInput(ctx context.Context, input io.Reader, pageURL *nurl.URL, contentType, contentLanguage string) (Article, error) {
+	if ps.MaxInputBytes > 0 {
+		input = &limitedReader{r: input, max: ps.MaxInputBytes}
+	}
+
+	// Decode input
+	r, encoding, err := decodeInput(input, contentType)
+	if errors.Is(err, ErrInputTooLarge) || errors.Is(err, ErrBodyTooLarge) {
+		return Article{}, err
+	} else if err != nil {
+		return Article{}, fmt.Errorf("%w: %w", ErrParse, err)
+	}
+
+	// Check the limits before building the DOM. The decoded input is kept
+	// while it's checked, so the HTML parser reads it from the buffer.
+	if ps.MaxElemsToParse > 0 || ps.MaxNestingDepth > 0 {
+		var decoded bytes.Buffer
+		if err := ps.checkInputLimits(io.TeeReader(r, &decoded)); err != nil {
+			if errors.Is(err, ErrTooManyElements) || errors.Is(err, ErrTooDeeplyNested) {
+				return Article{}, err
+			}
+			return Article{}, fmt.Errorf("%w: %w", ErrParse, err)
+		}
+		r = io.MultiReader(&decoded, r)
+	}
+
 	// Parse input
-	doc, err := dom.Parse(input)
+	doc, err := html.Parse(r)
 	if err != nil {
-		return Article{}, fmt.Errorf("failed to parse input: %v", err)
+		return Article{}, fmt.Errorf("%w: %w", ErrParse, err)
 	}
 
-	return ps.ParseDocument(doc, pageURL)
+	article, err := ps.parseDocument(ctx, doc, pageURL, contentLanguage)
+	if err == nil || errors.Is(err, ErrNoContent) {
+		article.Encoding = encoding
+	}
+
+	return article, err
+}
+
+// ParseURL fetches the web page from pageURL using fetcher, then parses the
+// response to find the readable content. If fetcher is nil, the page is
+// fetched using http.DefaultClient. Responses with a non-2xx status are
+// rejected with a *FetchError. If the page was redirected, the final URL
+// is used to resolve the relative URIs in the article.
+func (ps *Parser) ParseURL(ctx context.Context, pageURL string, fetcher Fetcher) (Article, error) {
+	// Make sure URL is valid
+	parsedURL, err := nurl.ParseRequestURI(pageURL)
+	if err != nil {
+		return Article{}, fmt.Errorf("%w: %w", ErrInvalidURL, err)
+	}
+
+	if fetcher == nil {
+		fetcher = NewHTTPFetcher(nil)
+	}
+
+	// Fetch page from URL
+	resp, err := fetcher.Fetch(ctx, pageURL)
+	if err != nil {
+		return Article{}, &FetchError{URL: pageURL, Err: err}
+	}
+	defer resp.Body.Close()
+
+	// Make sure the page was actually found
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return Article{}, &FetchError{URL: pageURL, StatusCode: resp.StatusCode}
+	}
+
+	// Use the final URL after any redirects
+	if resp.Request != nil && resp.Request.URL != nil {
+		parsedURL = resp.Request.URL
+	}
+
+	// Make sure content type is HTML
+	cp := resp.Header.Get("Content-Type")
+	if !strings.Contains(cp, "text/html") {
+		return Article{}, &NotHTMLError{ContentType: cp}
+	}
+
+	// Parse content. A body that turns out to be too large while it's
+	// read fails like one whose Content-Length is too large.
+	article, err := ps.parseInput(ctx, resp.Body, parsedURL, cp, resp.Header.Get("Content-Language"))
+	if errors.Is(err, ErrBodyTooLarge) {
+		return Article{}, &FetchError{URL: pageURL, Err: err}
+	}
+	return article, err
 }
 
 // ParseDocument parses the specified document and find the main readable content.
+// If the content is shorter than CharThresholds, the article is returned along
+// with ErrNoContent.
 func (ps *Parser) ParseDocument(doc *html.Node, pageURL *nurl.URL) (Article, error) {
+	return ps.ParseDocumentContext(context.Background(), doc, pageURL)
+}
+
+// ParseDocumentContext is like ParseDocument, but stops the extraction as
+// soon as ctx is cancelled. In that case the returned error is ctx.Err().
+func (ps *Parser) ParseDocumentContext(ctx context.Context, doc *html.Node, pageURL *nurl.URL) (Article, error) {
+	if err := ctx.Err(); err != nil {
+		return Article{}, err
+	}
+
 	// Clone document to make sure the original kept untouched
-	ps.doc = dom.Clone(doc, true)
+	return ps.parseDocument(ctx, dom.Clone(doc, true), pageURL, "")
+}
+
+// parseDocument finds the main readable content of doc, which is modified
+// in the process, so the callers must own it. The content language is the
+// Content-Language header of the page, if it's known. The parse runs on a
+// copy of the parser with a new state, so ps isn't modified.
+func (ps *Parser) parseDocument(ctx context.Context, doc *html.Node, pageURL *nurl.URL, contentLanguage string) (Article, error) {
+	run := *ps
+	run.parseState = parseState{}
+	run.Scoring = ps.Scoring.withDefaults()
+	return run.run(ctx, doc, pageURL, contentLanguage)
+}
+
+// run finds the main readable content of doc, using the state of ps.
+func (ps *Parser) run(ctx context.Context, doc *html.Node, pageURL *nurl.URL, contentLanguage string) (Article, error) {
+	if err := ctx.Err(); err != nil {
+		return Article{}, err
+	}
 
-	// Reset parser data
-	ps.articleTitle = ""
-	ps.articleByline = ""
-	ps.articleDir = ""
-	ps.articleSiteName = ""
+	// Initialize parser data
+	ps.doc = doc
 	ps.documentURI = pageURL
-	ps.attempts = []parseAttempt{}
+	ps.baseURI = ps.getBaseURI()
+	if ps.Trace || ps.DebugHTML {
+		ps.trace = &Trace{FinalAttempt: -1}
+	}
+
+	if ps.DebugHTML {
+		ps.prepDebugHTML()
+	}
 	ps.flags = flags{
 		stripUnlikelys:     true,
 		useWeightClasses:   true,
 		cleanConditionally: true,
 	}
 
-	// Avoid parsing too large documents, as per configuration option
+	// Avoid parsing too large documents, as per configuration option. The
+	// input of Parse was already checked while it was tokenized, but this
+	// also counts the elements that are implied by the HTML parser, and
+	// checks the documents given to ParseDocument, which aren't tokenized.
 	if ps.MaxElemsToParse > 0 {
 		numTags := len(dom.GetElementsByTagName(ps.doc, "*"))
 		if numTags > ps.MaxElemsToParse {
-			return Article{}, fmt.Errorf("documents too large: %d elements", numTags)
+			return Article{}, &TooManyElementsError{Count: numTags, Max: ps.MaxElemsToParse}
 		}
 	}
 
@@ -54,10 +186,34 @@ func (ps *Parser) ParseDocument(doc *html.Node, pageURL *nurl.URL) (Article, err
 
 	// Extract JSON-LD metadata before removing scripts
 	var jsonLd map[string]string
+	var jsonLdMetadata Metadata
+	var jobPosting *JobPosting
+	var jsonLdAuthors []Author
 	if !ps.DisableJSONLD {
-		jsonLd, _ = ps.getJSONLD()
+		objects := ps.getJSONLDObjects()
+		ids := jsonLdIDs(objects)
+		jsonLd, jsonLdMetadata = ps.getJSONLD(objects, ids)
+		jsonLdAuthors = ps.getJSONLDAuthors(objects, ids)
+
+		if ps.ExtractJobPosting {
+			jobPosting = ps.getJSONLDJobPosting(objects, ids)
+		}
 	}
 
+	// Find the canonical URL, which may be used as the base for
+	// relative URLs if the page URL is unknown.
+	canonicalURL, ampURL, alternates := ps.getArticleLinks()
+	if ps.documentURI == nil && ps.UseCanonicalURL {
+		if parsedURL, err := nurl.ParseRequestURI(canonicalURL); err == nil && parsedURL.Host != "" {
+			ps.documentURI = parsedURL
+			ps.baseURI = ps.getBaseURI()
+		}
+	}
+
+	// Find the authors linked from the document, before the links
+	// are removed while grabbing the article.
+	relAuthors := ps.getRelAuthors()
+
 	// Remove script tags from the document.
 	ps.removeScripts(ps.doc)
 
@@ -65,16 +221,67 @@ func (ps *Parser) ParseDocument(doc *html.Node, pageURL *nurl.URL) (Article, err
 	ps.prepDocument()
 
 	// Fetch metadata
-	metadata := ps.getArticleMetadata(jsonLd)
+	metadata, allMetadata := ps.getArticleMetadata(jsonLd, jsonLdMetadata)
 	ps.articleTitle = metadata["title"]
 
+	// Use the rule of the site, if there is one, and fall back to the
+	// heuristics for the content if the rule doesn't select it.
+	var articleContent *html.Node
+	var err error
+	var siteRuleName string
+	var siteRuleAuthors []Author
+	var hostname string
+	if ps.documentURI != nil {
+		hostname = ps.documentURI.Hostname()
+	}
+
+	if rule := ps.SiteRules.match(hostname); rule != nil {
+		siteRuleName = rule.Name
+		siteRuleAuthors = ps.applySiteRule(rule, metadata)
+
+		articleContent, err = ps.getSiteRuleContent(ctx, rule)
+		if err != nil {
+			return Article{}, err
+		}
+	}
+
 	// Try to grab article content
 	finalHTMLContent := ""
 	finalTextContent := ""
-	articleContent := ps.grabArticle()
+	if articleContent == nil {
+		articleContent, err = ps.grabArticle(ctx)
+		if err != nil {
+			return Article{}, err
+		}
+	}
+
+	// Job pages often have little content outside of the description
+	// in their JSON-LD, so use the description when it's longer.
+	if jobPosting != nil && jobPosting.Description != "" {
+		descriptionContent := ps.getJobPostingContent(jobPosting.Description)
+		if descriptionContent != nil && (articleContent == nil ||
+			charCount(ps.getInnerText(articleContent, true)) < charCount(ps.getInnerText(descriptionContent, true))) {
+			articleContent = descriptionContent
+		}
+	}
+
+	// Find the nodes of the original page that ended up in the article,
+	// before their debug ids are cleared.
+	var debugSelectedIDs map[string]struct{}
+	if ps.DebugHTML {
+		debugSelectedIDs = getDebugIDs(articleContent)
+	}
+
 	var readableNode *html.Node
+	var errNoContent error
+
+	if articleContent == nil {
+		errNoContent = ErrNoContent
+	} else {
+		if charCount(ps.getInnerText(articleContent, true)) < ps.CharThresholds {
+			errNoContent = ErrNoContent
+		}
 
-	if articleContent != nil {
 		ps.postProcessContent(articleContent)
 
 		// If we haven't found an excerpt in the article's metadata,
@@ -91,6 +298,12 @@ func (ps *Parser) ParseDocument(doc *html.Node, pageURL *nurl.URL) (Article, err
 		finalHTMLContent = dom.InnerHTML(articleContent)
 		finalTextContent = dom.TextContent(articleContent)
 		finalTextContent = strings.TrimSpace(finalTextContent)
+
+		// If the direction isn't specified by the document, guess it
+		// from the article text.
+		if ps.articleDir == "" {
+			ps.articleDir = ps.getTextDirection(finalTextContent)
+		}
 	}
 
 	finalByline := metadata["byline"]
@@ -98,6 +311,24 @@ func (ps *Parser) ParseDocument(doc *html.Node, pageURL *nurl.URL) (Article, err
 		finalByline = ps.articleByline
 	}
 
+	var metaAuthors []Author
+	for _, value := range allMetadata["author"] {
+		if value.Source == MetadataName {
+			metaAuthors = append(metaAuthors, Author{Name: value.Value})
+		}
+	}
+
+	// The byline selected by a site rule is trusted, so it comes first.
+	// The byline node of the document often holds the job title, the
+	// section or the site name too, e.g. "Jane Doe, Senior Technologist",
+	// which can't be told apart from another author. So it isn't merged
+	// with the other sources, and it's only used when none of them names
+	// an author.
+	authors := mergeAuthors(siteRuleAuthors, jsonLdAuthors, metaAuthors, relAuthors)
+	if len(authors) == 0 {
+		authors = mergeAuthors(ps.getBylineAuthors(ps.articleByline))
+	}
+
 	// Excerpt is an supposed to be short and concise,
 	// so it shouldn't have any new line
 	excerpt := strings.TrimSpace(metadata["excerpt"])
@@ -118,6 +349,40 @@ func (ps *Parser) ParseDocument(doc *html.Node, pageURL *nurl.URL) (Article, err
 	publishedTime := ps.getDate(metadata, "publishedTime")
 	modifiedTime := ps.getDate(metadata, "modifiedTime")
 
+	// The language in JSON-LD is only used when the document itself
+	// doesn't specify one.
+	language := ps.articleLang
+	if language == "" {
+		language = metadata["language"]
+	}
+
+	resolvedLanguage := ps.getArticleLanguage(contentLanguage, metadata, allMetadata, finalTextContent)
+	stats := getArticleStats(articleContent, resolvedLanguage.Code)
+
+	var trace *Trace
+	if ps.Trace {
+		trace = ps.trace
+	}
+
+	var debugHTML string
+	if ps.DebugHTML {
+		debugHTML = ps.renderDebugHTML(ps.trace, debugSelectedIDs)
+	}
+
+	var keywords []string
+	for _, keyword := range allMetadata["keywords"] {
+		if keyword.Source == MetadataJSONLD {
+			keywords = append(keywords, keyword.Value)
+		}
+	}
+
+	wordCount, _ := strconv.Atoi(metadata["wordCount"])
+
+	var accessibleForFree *bool
+	if isFree, err := strconv.ParseBool(metadata["accessibleForFree"]); err == nil {
+		accessibleForFree = &isFree
+	}
+
 	return Article{
 		Title:         validTitle,
 		Byline:        validByline,
@@ -129,10 +394,31 @@ func (ps *Parser) ParseDocument(doc *html.Node, pageURL *nurl.URL) (Article, err
 		SiteName:      metadata["siteName"],
 		Image:         metadata["image"],
 		Favicon:       metadata["favicon"],
-		Language:      ps.articleLang,
+		Language:      language,
+		Dir:           ps.articleDir,
 		PublishedTime: publishedTime,
 		ModifiedTime:  modifiedTime,
-	}, nil
+
+		Keywords:          keywords,
+		Section:           metadata["section"],
+		WordCount:         wordCount,
+		AccessibleForFree: accessibleForFree,
+		MainEntityOfPage:  metadata["mainEntityOfPage"],
+		JobPosting:        jobPosting,
+		Metadata:          allMetadata,
+
+		CanonicalURL: canonicalURL,
+		AMPURL:       ampURL,
+		Alternates:   alternates,
+
+		Authors: authors,
+
+		ResolvedLanguage: resolvedLanguage,
+		Stats:            stats,
+		Trace:            trace,
+		DebugHTML:        debugHTML,
+		SiteRule:         siteRuleName,
+	}, errNoContent
 }
 
 // getDate tries to get a date from metadata, and parse it using a list of known formats.
@@ -149,7 +435,7 @@ func (ps *Parser) getDate(metadata map[string]string, fieldName string) *time.Ti
 func (ps *Parser) getParsedDate(dateStr string) *time.Time {
 	d, err := dateparse.ParseAny(dateStr)
 	if err != nil {
-		ps.logf("failed to parse date \"%s\": %v\n", dateStr, err)
+		ps.logDebug("failed to parse date", "date", dateStr, "error", err)
 		return nil
 	}
 	return &d
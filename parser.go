@@ -1,12 +1,12 @@
 package readability
 
 import (
-	"encoding/json"
-	"fmt"
+	"context"
 	shtml "html"
-	"log"
+	"log/slog"
 	"math"
 	nurl "net/url"
+	"os"
 	"regexp"
 	"sort"
 	"strconv"
@@ -15,6 +15,7 @@ import (
 
 	"github.com/go-shiori/dom"
 	"golang.org/x/net/html"
+	"golang.org/x/text/unicode/bidi"
 )
 
 // All of the regular expressions in use within readability.
@@ -25,6 +26,8 @@ var (
 	RxPositive             = regexp.MustCompile(`(?i)article|body|content|entry|hentry|h-entry|main|page|pagination|post|text|blog|story`)
 	RxNegative             = regexp.MustCompile(`(?i)-ad-|hidden|^hid$| hid$| hid |^hid |banner|combx|comment|com-|contact|foot|footer|footnote|gdpr|masthead|media|meta|outbrain|promo|related|scroll|share|shoutbox|sidebar|skyscraper|sponsor|shopping|tags|tool|widget`)
 	RxByline               = regexp.MustCompile(`(?i)byline|author|dateline|writtenby|p-author`)
+	RxBylinePrefix         = regexp.MustCompile(`(?i)^(?:(?:written|posted)\s+by|by|von)\b\s*:?\s*`)
+	RxBylineSeparator      = regexp.MustCompile(`(?i)\s*(?:,|&|\band\b|\bund\b)\s*`)
 	RxNormalize            = regexp.MustCompile(`(?i)\s{2,}`)
 	RxVideos               = regexp.MustCompile(`(?i)//(www\.)?((dailymotion|youtube|youtube-nocookie|player\.vimeo|v\.qq)\.com|(archive|upload\.wikimedia)\.org|player\.twitch\.tv)`)
 	RxTokenize             = regexp.MustCompile(`(?i)\W+`)
@@ -51,6 +54,7 @@ var (
 	RxJsonLdArticleTypes   = regexp.MustCompile(`(?i)^Article|AdvertiserContentArticle|NewsArticle|AnalysisNewsArticle|AskPublicNewsArticle|BackgroundNewsArticle|OpinionNewsArticle|ReportageNewsArticle|ReviewNewsArticle|Report|SatiricalArticle|ScholarlyArticle|MedicalScholarlyArticle|SocialMediaPosting|BlogPosting|LiveBlogPosting|DiscussionForumPosting|TechArticle|APIReference$`)
 	RxCDATA                = regexp.MustCompile(`^\s*<!\[CDATA\[|\]\]>\s*$`)
 	RxSchemaOrg            = regexp.MustCompile(`(?i)^https?\:\/\/schema\.org\/?$`)
+	RxCharset              = regexp.MustCompile(`(?i)charset\s*=\s*["']?\s*([\w:.-]+)`)
 	// Commas as used in Latin, Sindhi, Chinese and various other scripts.
 	// see: https://en.wikipedia.org/wiki/Comma#Comma_variants
 	RxCommas = regexp.MustCompile("\u002C|\u060C|\uFE50|\uFE10|\uFE11|\u2E41|\u2E34|\u2E32|\uFF0C")
@@ -58,7 +62,6 @@ var (
 
 // Constants that used by readability.
 var (
-	unlikelyRoles                = sliceToMap("menu", "menubar", "complementary", "navigation", "alert", "alertdialog", "dialog")
 	divToPElems                  = sliceToMap("blockquote", "dl", "div", "img", "ol", "p", "pre", "table", "ul", "select")
 	alterToDivExceptions         = []string{"div", "article", "section", "p"}
 	presentationalAttributes     = []string{"align", "background", "bgcolor", "border", "cellpadding", "cellspacing", "frame", "hspace", "rules", "style", "valign", "vspace"}
@@ -78,10 +81,12 @@ type flags struct {
 	cleanConditionally bool
 }
 
-// parseAttempt is container for the result of previous parse attempts.
+// parseAttempt is container for the result of a previous parse attempt.
+// The index is the position of the attempt.
 type parseAttempt struct {
 	articleContent *html.Node
 	textLength     int
+	index          int
 }
 
 // Article is the final readable content.
@@ -97,15 +102,55 @@ type Article struct {
 	Image         string
 	Favicon       string
 	Language      string
+	Dir           string
+	Encoding      string
 	PublishedTime *time.Time
 	ModifiedTime  *time.Time
+
+	Keywords          []string
+	Section           string
+	WordCount         int
+	AccessibleForFree *bool
+	MainEntityOfPage  string
+	JobPosting        *JobPosting
+	Metadata          Metadata
+
+	CanonicalURL string
+	AMPURL       string
+	Alternates   []AlternateLink
+
+	Authors []Author
+
+	ResolvedLanguage ResolvedLanguage
+	Stats            ArticleStats
+	Trace            *Trace
+	DebugHTML        string
+	SiteRule         string
+}
+
+// AlternateLink is a translation of the page, as specified by
+// <link rel="alternate" hreflang="...">.
+type AlternateLink struct {
+	HrefLang string
+	URL      string
 }
 
 // Parser is the parser that parses the page to get the readable content.
+// A Parser can be used by many goroutines at once, as long as its
+// configuration isn't changed meanwhile.
 type Parser struct {
 	// MaxElemsToParse is the max number of nodes supported by this
-	// parser. Default: 0 (no limit)
+	// parser. When the parser parses the input itself, the elements
+	// are counted before the DOM is built. Default: 0 (no limit)
 	MaxElemsToParse int
+	// MaxInputBytes is the max number of bytes of input that Parse,
+	// ParseContext and ParseURL will read. A larger input fails with
+	// ErrInputTooLarge without being parsed. Default: 0 (no limit)
+	MaxInputBytes int64
+	// MaxNestingDepth is the max depth of nested elements in the input
+	// of Parse, ParseContext and ParseURL. A deeper input fails with
+	// ErrTooDeeplyNested before the DOM is built. Default: 0 (no limit)
+	MaxNestingDepth int
 	// NTopCandidates is the number of top candidates to consider when
 	// analysing how tight the competition is among candidates.
 	NTopCandidates int
@@ -118,21 +163,72 @@ type Parser struct {
 	KeepClasses bool
 	// TagsToScore is element tags to score by default.
 	TagsToScore []string
-	// Debug determines if the log should be printed or not. Default: false.
+	// Scoring are the patterns and weights used to find the article
+	// content, e.g. to recognize the class names of ads in a language
+	// other than English. Default: DefaultScoringRules()
+	Scoring ScoringRules
+	// Debug determines if the log should be printed to the standard error
+	// when Logger isn't set. Default: false.
 	Debug bool
+	// Logger receives the log of the parser as structured records at
+	// debug level, e.g. the path and score of each candidate node. If
+	// it's nil, Debug is used instead. Default: nil.
+	Logger *slog.Logger
+	// Trace determines if the decisions made while grabbing the article
+	// will be recorded in Article.Trace. Default: false.
+	Trace bool
+	// DebugHTML determines if the original page will be rendered into
+	// Article.DebugHTML, annotated with the decisions made while grabbing
+	// the article: the scores of the candidates, the removed nodes and
+	// the nodes that are selected as the article. Default: false.
+	DebugHTML bool
 	// DisableJSONLD determines if metadata in JSON+LD will be extracted
 	// or not. Default: false.
 	DisableJSONLD bool
-
+	// ExtractJobPosting determines if Schema.org JobPosting in JSON+LD
+	// will be extracted into Article.JobPosting. When the page content
+	// is shorter than the job description, the description is used as
+	// the article content instead. Default: false.
+	ExtractJobPosting bool
+	// UseCanonicalURL determines if the canonical URL of the page will
+	// be used to resolve relative URLs when no page URL is given to the
+	// parser. Default: false.
+	UseCanonicalURL bool
+	// DisableBaseElement determines if <base href> will be ignored, so
+	// relative URLs are resolved against the page URL instead of the
+	// base URL of the document. Default: false.
+	DisableBaseElement bool
+	// DisableLanguageDetection determines if the language of the article
+	// will only be resolved from the languages declared by the page,
+	// without detecting it from the article text. Default: false.
+	DisableLanguageDetection bool
+	// SiteRules are the CSS selectors of the sites whose layout is known,
+	// which are used instead of the heuristics to find the content and
+	// metadata of their pages. Default: nil.
+	SiteRules *SiteRules
+
+	parseState
+}
+
+// parseState is the state of a single parse. It's kept apart from the
+// configuration of the Parser: each parse runs on a copy of the Parser
+// with its own state, so the Parser itself is never modified and can be
+// used by many goroutines at once.
+type parseState struct {
 	doc             *html.Node
 	documentURI     *nurl.URL
+	baseURI         *nurl.URL
 	articleTitle    string
 	articleByline   string
 	articleDir      string
 	articleSiteName string
 	articleLang     string
-	attempts        []parseAttempt
+	attempt         int
+	bestAttempt     *parseAttempt
+	nodeStates      map[*html.Node]nodeState
 	flags           flags
+	trace           *Trace
+	debugDoc        *html.Node
 }
 
 // NewParser returns new Parser which set up with default value.
@@ -144,6 +240,7 @@ func NewParser() Parser {
 		ClassesToPreserve: []string{"page"},
 		KeepClasses:       false,
 		TagsToScore:       []string{"section", "h2", "h3", "h4", "h5", "h6", "p", "td", "pre"},
+		Scoring:           DefaultScoringRules(),
 		Debug:             false,
 	}
 }
@@ -257,8 +354,51 @@ func (ps *Parser) cleanClasses(node *html.Node) {
 	}
 }
 
+// getBaseURI returns the URI that relative URIs in the document are
+// resolved against. Like document.baseURI, it's the href of the first
+// <base> element resolved against the document URI, or the document URI
+// itself if there is no such element.
+func (ps *Parser) getBaseURI() *nurl.URL {
+	if ps.DisableBaseElement {
+		return ps.documentURI
+	}
+
+	for _, base := range dom.GetElementsByTagName(ps.doc, "base") {
+		if !dom.HasAttribute(base, "href") {
+			continue
+		}
+
+		baseURI, err := nurl.Parse(strings.TrimSpace(dom.GetAttribute(base, "href")))
+		switch {
+		case err != nil:
+			return ps.documentURI
+		case ps.documentURI != nil:
+			return ps.documentURI.ResolveReference(baseURI)
+		case baseURI.IsAbs() && baseURI.Host != "":
+			return baseURI
+		default:
+			return nil
+		}
+	}
+
+	return ps.documentURI
+}
+
+// toAbsoluteURI converts uri to absolute URI, resolved against the base
+// URI of the document. Like in Readability.js, hash links are only
+// changed if the base URI is different from the document URI.
+func (ps *Parser) toAbsoluteURI(uri string) string {
+	if strings.HasPrefix(uri, "#") && ps.baseURI != nil && ps.documentURI != nil &&
+		ps.baseURI.String() != ps.documentURI.String() {
+		return ps.baseURI.ResolveReference(&nurl.URL{Fragment: uri[1:]}).String()
+	}
+
+	return toAbsoluteURI(uri, ps.baseURI)
+}
+
 // fixRelativeURIs converts each <a> and <img> uri in the given element
-// to an absolute URI, ignoring #ref URIs.
+// to an absolute URI, using the base URI of the document. #ref URIs are
+// ignored, unless <base> points somewhere else than the document.
 func (ps *Parser) fixRelativeURIs(articleContent *html.Node) {
 	links := ps.getAllNodesWithTag(articleContent, "a")
 	ps.forEachNode(links, func(link *html.Node, _ int) {
@@ -287,7 +427,7 @@ func (ps *Parser) fixRelativeURIs(articleContent *html.Node) {
 				dom.ReplaceChild(link.Parent, container, link)
 			}
 		} else {
-			newHref := toAbsoluteURI(href, ps.documentURI)
+			newHref := ps.toAbsoluteURI(href)
 			if newHref == "" {
 				dom.RemoveAttribute(link, "href")
 			} else {
@@ -303,19 +443,19 @@ func (ps *Parser) fixRelativeURIs(articleContent *html.Node) {
 		srcset := dom.GetAttribute(media, "srcset")
 
 		if src != "" {
-			newSrc := toAbsoluteURI(src, ps.documentURI)
+			newSrc := ps.toAbsoluteURI(src)
 			dom.SetAttribute(media, "src", newSrc)
 		}
 
 		if poster != "" {
-			newPoster := toAbsoluteURI(poster, ps.documentURI)
+			newPoster := ps.toAbsoluteURI(poster)
 			dom.SetAttribute(media, "poster", newPoster)
 		}
 
 		if srcset != "" {
 			newSrcset := RxSrcsetURL.ReplaceAllStringFunc(srcset, func(s string) string {
 				p := RxSrcsetURL.FindStringSubmatch(s)
-				return toAbsoluteURI(p[1], ps.documentURI) + p[2] + p[3]
+				return ps.toAbsoluteURI(p[1]) + p[2] + p[3]
 			})
 
 			dom.SetAttribute(media, "srcset", newSrcset)
@@ -537,7 +677,8 @@ func (ps *Parser) setNodeTag(node *html.Node, newTagName string) {
 
 // prepArticle prepares the article node for display. Clean out any
 // inline styles, iframes, forms, strip extraneous <p> tags, etc.
-func (ps *Parser) prepArticle(articleContent *html.Node) {
+// It returns ctx.Err() if the context is cancelled while cleaning.
+func (ps *Parser) prepArticle(ctx context.Context, articleContent *html.Node) error {
 	ps.cleanStyles(articleContent)
 
 	// Check for data tables before we continue, to avoid removing
@@ -549,8 +690,12 @@ func (ps *Parser) prepArticle(articleContent *html.Node) {
 	ps.fixLazyImages(articleContent)
 
 	// Clean out junk from the article content
-	ps.cleanConditionally(articleContent, "form")
-	ps.cleanConditionally(articleContent, "fieldset")
+	for _, tag := range []string{"form", "fieldset"} {
+		if err := ps.cleanConditionally(ctx, articleContent, tag); err != nil {
+			return err
+		}
+	}
+
 	ps.clean(articleContent, "object")
 	ps.clean(articleContent, "embed")
 	ps.clean(articleContent, "footer")
@@ -577,9 +722,11 @@ func (ps *Parser) prepArticle(articleContent *html.Node) {
 
 	// Do these last as the previous stuff may have removed junk
 	// that will affect these
-	ps.cleanConditionally(articleContent, "table")
-	ps.cleanConditionally(articleContent, "ul")
-	ps.cleanConditionally(articleContent, "div")
+	for _, tag := range []string{"table", "ul", "div"} {
+		if err := ps.cleanConditionally(ctx, articleContent, tag); err != nil {
+			return err
+		}
+	}
 
 	// Replace H1 with H2 as H1 should be only title that is displayed separately
 	ps.replaceNodeTags(ps.getAllNodesWithTag(articleContent, "h1"), "h2")
@@ -626,23 +773,15 @@ func (ps *Parser) prepArticle(articleContent *html.Node) {
 			}
 		}
 	})
+
+	return nil
 }
 
 // initializeNode initializes a node with the readability score.
 // Also checks the className/id for special names to add to its score.
 func (ps *Parser) initializeNode(node *html.Node) {
 	contentScore := float64(ps.getClassWeight(node))
-	switch dom.TagName(node) {
-	case "div":
-		contentScore += 5
-	case "pre", "td", "blockquote":
-		contentScore += 3
-	case "address", "form":
-		contentScore -= 3
-	case "h1", "h2", "h3", "h4", "h5", "h6", "th":
-		contentScore -= 5
-	}
-
+	contentScore += ps.Scoring.TagScores[dom.TagName(node)]
 	ps.setContentScore(node, contentScore)
 }
 
@@ -720,7 +859,7 @@ func (ps *Parser) checkByline(node *html.Node, matchString string) bool {
 	rel := dom.GetAttribute(node, "rel")
 	itemprop := dom.GetAttribute(node, "itemprop")
 	nodeText := dom.TextContent(node)
-	if (rel == "author" || strings.Contains(itemprop, "author") || RxByline.MatchString(matchString)) &&
+	if (rel == "author" || strings.Contains(itemprop, "author") || ps.Scoring.Byline.MatchString(matchString)) &&
 		ps.isValidByline(nodeText) {
 		nodeText = strings.TrimSpace(nodeText)
 		nodeText = strings.Join(strings.Fields(nodeText), " ")
@@ -766,21 +905,48 @@ func (ps *Parser) getNodeAncestors(node *html.Node, maxDepth int) []*html.Node {
 // grabArticle uses a variety of metrics (content score, classname,
 // element types), find the content that is most likely to be the
 // stuff a user wants to read. Then return it wrapped up in a div.
-func (ps *Parser) grabArticle() *html.Node {
-	ps.log("**** GRAB ARTICLE ****")
-
+// The context is checked between attempts and while scoring, so a
+// cancelled context stops the extraction with ctx.Err().
+func (ps *Parser) grabArticle(ctx context.Context) (*html.Node, error) {
+	// Each attempt modifies the document, so a clone of it is saved
+	// before the first attempt to restore it for the next ones.
+	var savedRoot *html.Node
 	for {
-		doc := dom.Clone(ps.doc, true)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 
-		var page *html.Node
-		if nodes := dom.GetElementsByTagName(doc, "body"); len(nodes) > 0 {
-			page = nodes[0]
+		if ps.attempt > 0 {
+			ps.restoreDocument(savedRoot, !ps.isLastAttempt())
+		} else if !ps.isLastAttempt() {
+			savedRoot = dom.Clone(dom.DocumentElement(ps.doc), true)
 		}
 
+		doc := ps.doc
+		page := dom.QuerySelector(doc, "body")
+
 		// We can't grab an article if we don't have a page!
 		if page == nil {
-			ps.log("no body found in document, abort")
-			return nil
+			ps.logDebug("no body found in document, abort")
+			return nil, nil
+		}
+
+		// The states of the nodes of the previous attempt aren't
+		// needed anymore.
+		ps.nodeStates = make(map[*html.Node]nodeState)
+
+		ps.logDebug("grabbing article",
+			"attempt", ps.attempt,
+			"stripUnlikelys", ps.flags.stripUnlikelys,
+			"useWeightClasses", ps.flags.useWeightClasses,
+			"cleanConditionally", ps.flags.cleanConditionally)
+
+		if ps.trace != nil {
+			ps.trace.Attempts = append(ps.trace.Attempts, TraceAttempt{
+				StripUnlikelys:     ps.flags.stripUnlikelys,
+				UseWeightClasses:   ps.flags.useWeightClasses,
+				CleanConditionally: ps.flags.cleanConditionally,
+			})
 		}
 
 		// First, node prepping. Trash nodes that look cruddy (like ones
@@ -799,7 +965,7 @@ func (ps *Parser) grabArticle() *html.Node {
 			}
 
 			if !ps.isProbablyVisible(node) {
-				ps.logf("removing hidden node: %q\n", matchString)
+				ps.logRemoval(node, RemovalHidden, matchString, "")
 				node = ps.removeAndGetNext(node)
 				continue
 			}
@@ -808,6 +974,7 @@ func (ps *Parser) grabArticle() *html.Node {
 			// and "role = dialog"
 			if dom.GetAttribute(node, "aria-modal") == "true" &&
 				dom.GetAttribute(node, "role") == "dialog" {
+				ps.logRemoval(node, RemovalDialog, matchString, "")
 				node = ps.removeAndGetNext(node)
 				continue
 			}
@@ -815,14 +982,14 @@ func (ps *Parser) grabArticle() *html.Node {
 			// Check to see if this node is a byline, and remove it if
 			// it is true.
 			if ps.checkByline(node, matchString) {
+				ps.logRemoval(node, RemovalByline, matchString, "")
 				node = ps.removeAndGetNext(node)
 				continue
 			}
 
 			if shouldRemoveTitleHeader && ps.headerDuplicatesTitle(node) {
-				ps.logf("removing header: %q duplicate of %q\n",
-					trim(dom.TextContent(node)), trim(ps.articleTitle))
 				shouldRemoveTitleHeader = false
+				ps.logRemoval(node, RemovalDuplicateHeader, matchString, trim(ps.articleTitle))
 				node = ps.removeAndGetNext(node)
 				continue
 			}
@@ -830,19 +997,18 @@ func (ps *Parser) grabArticle() *html.Node {
 			// Remove unlikely candidates
 			nodeTagName := dom.TagName(node)
 			if ps.flags.stripUnlikelys {
-				if RxUnlikelyCandidates.MatchString(matchString) &&
-					!RxOkMaybeItsACandidate.MatchString(matchString) &&
+				if ps.Scoring.isUnlikelyCandidate(matchString) &&
 					!ps.hasAncestorTag(node, "table", 3, nil) &&
 					!ps.hasAncestorTag(node, "code", 3, nil) &&
 					nodeTagName != "body" && nodeTagName != "a" {
-					ps.logf("removing unlikely candidate: %q\n", matchString)
+					ps.logRemoval(node, RemovalUnlikelyCandidate, matchString, trim(matchString))
 					node = ps.removeAndGetNext(node)
 					continue
 				}
 
 				role := dom.GetAttribute(node, "role")
-				if _, include := unlikelyRoles[role]; include {
-					ps.logf("removing content with role %q: %q\n", role, matchString)
+				if ps.Scoring.isUnlikelyRole(role) {
+					ps.logRemoval(node, RemovalRole, matchString, role)
 					node = ps.removeAndGetNext(node)
 					continue
 				}
@@ -854,6 +1020,7 @@ func (ps *Parser) grabArticle() *html.Node {
 			case "div", "section", "header",
 				"h1", "h2", "h3", "h4", "h5", "h6":
 				if ps.isElementWithoutContent(node) {
+					ps.logRemoval(node, RemovalEmpty, matchString, "")
 					node = ps.removeAndGetNext(node)
 					continue
 				}
@@ -911,21 +1078,25 @@ func (ps *Parser) grabArticle() *html.Node {
 		// parent node. A score is determined by things like number of
 		// commas, class names, etc. Maybe eventually link density.
 		var candidates []*html.Node
-		ps.forEachNode(elementsToScore, func(elementToScore *html.Node, _ int) {
+		for _, elementToScore := range elementsToScore {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
 			if elementToScore.Parent == nil || dom.TagName(elementToScore.Parent) == "" {
-				return
+				continue
 			}
 
 			// If this paragraph is less than 25 characters, don't even count it.
 			innerText := ps.getInnerText(elementToScore, true)
 			if charCount(innerText) < 25 {
-				return
+				continue
 			}
 
 			// Exclude nodes with no ancestor.
 			ancestors := ps.getNodeAncestors(elementToScore, 5)
 			if len(ancestors) == 0 {
-				return
+				continue
 			}
 
 			// Add a point for the paragraph itself as a base.
@@ -966,7 +1137,7 @@ func (ps *Parser) grabArticle() *html.Node {
 				ancestorScore += float64(contentScore) / float64(scoreDivider)
 				ps.setContentScore(ancestor, ancestorScore)
 			})
-		})
+		}
 
 		// These lines are a bit different compared to Readability.js.
 		// In Readability.js, they fetch NTopCandidates utilising array
@@ -978,11 +1149,28 @@ func (ps *Parser) grabArticle() *html.Node {
 		// Scale the final candidates score based on link density. Good
 		// content should have a relatively small link density (5% or
 		// less) and be mostly unaffected by this operation.
+		traceAttempt := ps.currentTraceAttempt()
 		for i := 0; i < len(candidates); i++ {
 			candidate := candidates[i]
-			candidateScore := ps.getContentScore(candidate) * (1 - ps.getLinkDensity(candidate))
-			ps.logf("candidate %q with score: %f\n", dom.OuterHTML(candidate), candidateScore)
+			rawScore := ps.getContentScore(candidate)
+			linkDensity := ps.getLinkDensity(candidate)
+			candidateScore := rawScore * (1 - linkDensity)
+			ps.logDebug("scored candidate",
+				"attempt", ps.attempt,
+				"node", logNode{candidate},
+				"score", rawScore,
+				"linkDensity", linkDensity,
+				"adjustedScore", candidateScore)
 			ps.setContentScore(candidate, candidateScore)
+
+			if traceAttempt != nil {
+				traceAttempt.Candidates = append(traceAttempt.Candidates, TraceCandidate{
+					Node:          newTraceNode(candidate),
+					Score:         rawScore,
+					LinkDensity:   linkDensity,
+					AdjustedScore: candidateScore,
+				})
+			}
 		}
 
 		// After we've calculated scores, sort through all of the possible
@@ -1014,7 +1202,7 @@ func (ps *Parser) grabArticle() *html.Node {
 			// Move everything (not just elements, also text nodes etc.)
 			// into the container so we even include text directly in the body:
 			for page.FirstChild != nil {
-				ps.logf("moving child out: %q\n", dom.OuterHTML(page.FirstChild))
+				ps.logDebug("moving child out", "node", logNode{page.FirstChild})
 				dom.AppendChild(topCandidate, page.FirstChild)
 			}
 
@@ -1115,6 +1303,12 @@ func (ps *Parser) grabArticle() *html.Node {
 		topCandidateScore := ps.getContentScore(topCandidate)
 		topCandidateClassName := dom.ClassName(topCandidate)
 
+		if traceAttempt != nil {
+			topCandidateNode := newTraceNode(topCandidate)
+			traceAttempt.TopCandidate = &topCandidateNode
+			traceAttempt.TopCandidateScore = topCandidateScore
+		}
+
 		parentOfTopCandidate = topCandidate.Parent
 		siblings := dom.Children(parentOfTopCandidate)
 		for s := 0; s < len(siblings); s++ {
@@ -1125,6 +1319,7 @@ func (ps *Parser) grabArticle() *html.Node {
 				appendNode = true
 			} else {
 				contentBonus := float64(0)
+				var reason SiblingReason
 
 				// Give a bonus if sibling nodes and top candidates have the example same classname
 				if dom.ClassName(sibling) == topCandidateClassName && topCandidateClassName != "" {
@@ -1133,6 +1328,7 @@ func (ps *Parser) grabArticle() *html.Node {
 
 				if ps.hasContentScore(sibling) && ps.getContentScore(sibling)+contentBonus >= siblingScoreThreshold {
 					appendNode = true
+					reason = SiblingScore
 				} else if dom.TagName(sibling) == "p" {
 					linkDensity := ps.getLinkDensity(sibling)
 					nodeContent := ps.getInnerText(sibling, true)
@@ -1140,11 +1336,22 @@ func (ps *Parser) grabArticle() *html.Node {
 
 					if nodeLength > 80 && linkDensity < 0.25 {
 						appendNode = true
+						reason = SiblingParagraph
 					} else if nodeLength < 80 && nodeLength > 0 && linkDensity == 0 &&
 						RxSentencePeriod.MatchString(nodeContent) {
 						appendNode = true
+						reason = SiblingParagraph
 					}
 				}
+
+				if appendNode && traceAttempt != nil {
+					traceAttempt.Siblings = append(traceAttempt.Siblings, TraceSibling{
+						Node:   newTraceNode(sibling),
+						Score:  ps.getContentScore(sibling),
+						Bonus:  contentBonus,
+						Reason: reason,
+					})
+				}
 			}
 
 			if appendNode {
@@ -1166,7 +1373,9 @@ func (ps *Parser) grabArticle() *html.Node {
 
 		// So we have all of the content that we need. Now we clean
 		// it up for presentation.
-		ps.prepArticle(articleContent)
+		if err := ps.prepArticle(ctx, articleContent); err != nil {
+			return nil, err
+		}
 
 		if neededToCreateTopCandidate {
 			// We already created a fake div thing, and there wouldn't
@@ -1205,205 +1414,155 @@ func (ps *Parser) grabArticle() *html.Node {
 		// the sieve approach gives us a higher likelihood of
 		// finding the -right- content.
 		textLength := charCount(ps.getInnerText(articleContent, true))
+		if traceAttempt != nil {
+			traceAttempt.TextLength = textLength
+		}
+
 		if textLength < ps.CharThresholds {
 			parseSuccessful = false
 
-			if ps.flags.stripUnlikelys {
-				ps.flags.stripUnlikelys = false
-				ps.attempts = append(ps.attempts, parseAttempt{
+			// Only the attempt with the longest text is kept, which is
+			// the earliest one if there's a tie.
+			if ps.bestAttempt == nil || textLength > ps.bestAttempt.textLength {
+				ps.bestAttempt = &parseAttempt{
 					articleContent: articleContent,
 					textLength:     textLength,
-				})
+					index:          ps.attempt,
+				}
+			}
+
+			if ps.flags.stripUnlikelys {
+				ps.flags.stripUnlikelys = false
 			} else if ps.flags.useWeightClasses {
 				ps.flags.useWeightClasses = false
-				ps.attempts = append(ps.attempts, parseAttempt{
-					articleContent: articleContent,
-					textLength:     textLength,
-				})
 			} else if ps.flags.cleanConditionally {
 				ps.flags.cleanConditionally = false
-				ps.attempts = append(ps.attempts, parseAttempt{
-					articleContent: articleContent,
-					textLength:     textLength,
-				})
 			} else {
-				ps.attempts = append(ps.attempts, parseAttempt{
-					articleContent: articleContent,
-					textLength:     textLength,
-				})
-
 				// No luck after removing flags, just return the
-				// longest text we found during the different loops *
-				sort.Slice(ps.attempts, func(i, j int) bool {
-					return ps.attempts[i].textLength > ps.attempts[j].textLength
-				})
-
+				// longest text we found during the different loops.
 				// But first check if we actually have something
-				if ps.attempts[0].textLength == 0 {
-					return nil
+				if ps.bestAttempt.textLength == 0 {
+					return nil, nil
 				}
 
-				articleContent = ps.attempts[0].articleContent
+				articleContent = ps.bestAttempt.articleContent
 				parseSuccessful = true
+				if ps.trace != nil {
+					ps.trace.FinalAttempt = ps.bestAttempt.index
+				}
 			}
 		}
 
-		if parseSuccessful {
-			return articleContent
-		}
-	}
-}
-
-// isValidByline checks whether the input string could be a byline.
-// This verifies that the input is a string, and that the length
-// is less than 100 chars.
-func (ps *Parser) isValidByline(byline string) bool {
-	byline = strings.TrimSpace(byline)
-	nChar := charCount(byline)
-	return nChar > 0 && nChar < 100
-}
-
-// getJSONLD try to extract metadata from JSON-LD object.
-// For now, only Schema.org objects of type Article or its subtypes are supported.
-func (ps *Parser) getJSONLD() (map[string]string, error) {
-	var metadata map[string]string
-
-	scripts := dom.QuerySelectorAll(ps.doc, `script[type="application/ld+json"]`)
-	ps.forEachNode(scripts, func(jsonLdElement *html.Node, _ int) {
-		if metadata != nil {
-			return
+		if parseSuccessful && ps.trace != nil && ps.trace.FinalAttempt < 0 {
+			ps.trace.FinalAttempt = len(ps.trace.Attempts) - 1
 		}
 
-		// Strip CDATA markers if present
-		content := RxCDATA.ReplaceAllString(dom.TextContent(jsonLdElement), "")
-
-		// Decode JSON
-		var parsed map[string]interface{}
-		err := json.Unmarshal([]byte(content), &parsed)
-		if err != nil {
-			ps.logf("error while decoding json: %v", err)
-			return
-		}
-
-		// Check context
-		strContext, isString := parsed["@context"].(string)
-		if !isString || !RxSchemaOrg.MatchString(strContext) {
-			return
-		}
-
-		// If parsed doesn't have any @type, find it in its graph list
-		if _, typeExist := parsed["@type"]; !typeExist {
-			graphList, isArray := parsed["@graph"].([]interface{})
-			if !isArray {
-				return
+		if parseSuccessful {
+			// Find out text direction from ancestors of final top candidate.
+			ancestors := []*html.Node{parentOfTopCandidate, topCandidate}
+			if parentOfTopCandidate != nil {
+				ancestors = append(ancestors, ps.getNodeAncestors(parentOfTopCandidate, 0)...)
 			}
 
-			for _, graph := range graphList {
-				objGraph, isObj := graph.(map[string]interface{})
-				if !isObj {
-					continue
+			ps.someNode(ancestors, func(ancestor *html.Node) bool {
+				if ancestor == nil || ancestor.Type != html.ElementNode {
+					return false
 				}
 
-				strType, isString := objGraph["@type"].(string)
-				if isString && RxJsonLdArticleTypes.MatchString(strType) {
-					parsed = objGraph
-					break
+				if articleDir := strings.TrimSpace(dom.GetAttribute(ancestor, "dir")); articleDir != "" {
+					ps.articleDir = articleDir
+					return true
 				}
-			}
-		}
-
-		// Once again, make sure parsed has valid @type
-		strType, isString := parsed["@type"].(string)
-		if !isString || !RxJsonLdArticleTypes.MatchString(strType) {
-			return
-		}
-
-		// Initiate metadata
-		metadata = make(map[string]string)
-
-		// Title
-		name, nameIsString := parsed["name"].(string)
-		headline, headlineIsString := parsed["headline"].(string)
-
-		if nameIsString && headlineIsString && name != headline {
-			// We have both name and headline element in the JSON-LD. They should both be the same
-			// but some websites like aktualne.cz put their own name into "name" and the article
-			// title to "headline" which confuses Readability. So we try to check if either "name"
-			// or "headline" closely matches the html title, and if so, use that one. If not, then
-			// we use "name" by default.
-			title := ps.getArticleTitle()
-			nameMatches := ps.textSimilarity(name, title) > 0.75
-			headlineMatches := ps.textSimilarity(headline, title) > 0.75
+				return false
+			})
 
-			if headlineMatches && !nameMatches {
-				metadata["title"] = headline
-			} else {
-				metadata["title"] = name
-			}
-		} else if name, isString := parsed["name"].(string); isString {
-			metadata["title"] = strings.TrimSpace(name)
-		} else if headline, isString := parsed["headline"].(string); isString {
-			metadata["title"] = strings.TrimSpace(headline)
+			return articleContent, nil
 		}
 
-		// Author
-		switch val := parsed["author"].(type) {
-		case map[string]interface{}:
-			if name, isString := val["name"].(string); isString {
-				metadata["byline"] = strings.TrimSpace(name)
-			}
-
-		case []interface{}:
-			var authors []string
-			for _, author := range val {
-				objAuthor, isObj := author.(map[string]interface{})
-				if !isObj {
-					continue
-				}
-
-				if name, isString := objAuthor["name"].(string); isString {
-					authors = append(authors, strings.TrimSpace(name))
-				}
-			}
-			metadata["byline"] = strings.Join(authors, ", ")
-		}
+		ps.attempt++
+	}
+}
 
-		// Description
-		if description, isString := parsed["description"].(string); isString {
-			metadata["excerpt"] = strings.TrimSpace(description)
-		}
+// isLastAttempt determines if grabArticle won't try again after the current
+// attempt, because all of the flags are already turned off.
+func (ps *Parser) isLastAttempt() bool {
+	return !ps.flags.stripUnlikelys && !ps.flags.useWeightClasses && !ps.flags.cleanConditionally
+}
 
-		// Publisher
-		if objPublisher, isObj := parsed["publisher"].(map[string]interface{}); isObj {
-			if name, isString := objPublisher["name"].(string); isString {
-				metadata["siteName"] = strings.TrimSpace(name)
-			}
-		}
+// restoreDocument replaces the root element of the document with savedRoot,
+// the clone that was saved before the first attempt. The clone is cloned
+// again if it's needed by another attempt.
+func (ps *Parser) restoreDocument(savedRoot *html.Node, keepSaved bool) {
+	root := savedRoot
+	if keepSaved {
+		root = dom.Clone(savedRoot, true)
+	}
 
-		// DatePublished
-		if datePublished, isString := parsed["datePublished"].(string); isString {
-			metadata["datePublished"] = datePublished
+	current := dom.DocumentElement(ps.doc)
+	ps.doc.InsertBefore(root, current)
+	ps.doc.RemoveChild(current)
+}
+
+// rtlTextRatio is the min share of right-to-left characters, among the
+// characters with a strong direction, for the text to be guessed as "rtl".
+const rtlTextRatio = 0.75
+
+// getTextDirection guesses the direction of text from the bidi class of
+// its characters. It returns "rtl" if right-to-left characters clearly
+// dominate the characters with a strong direction, or an empty string
+// otherwise, like Readability.js does when no dir attribute is found.
+func (ps *Parser) getTextDirection(text string) string {
+	var nLTR, nRTL int
+	for _, r := range text {
+		props, _ := bidi.LookupRune(r)
+		switch props.Class() {
+		case bidi.L:
+			nLTR++
+		case bidi.R, bidi.AL:
+			nRTL++
 		}
+	}
 
-	})
+	if nRTL > 0 && float64(nRTL) >= rtlTextRatio*float64(nRTL+nLTR) {
+		return "rtl"
+	}
+	return ""
+}
 
-	return metadata, nil
+// isValidByline checks whether the input string could be a byline.
+// This verifies that the input is a string, and that the length
+// is less than 100 chars.
+func (ps *Parser) isValidByline(byline string) bool {
+	byline = strings.TrimSpace(byline)
+	nChar := charCount(byline)
+	return nChar > 0 && nChar < 100
 }
 
 // getArticleMetadata attempts to get excerpt and byline
-// metadata for the article.
-func (ps *Parser) getArticleMetadata(jsonLd map[string]string) map[string]string {
+// metadata for the article. It also returns all of the metadata
+// that is found, including the fields that aren't used.
+func (ps *Parser) getArticleMetadata(jsonLd map[string]string, jsonLdMetadata Metadata) (map[string]string, Metadata) {
 	values := make(map[string]string)
+	allMetadata := make(Metadata)
 	metaElements := dom.GetElementsByTagName(ps.doc, "meta")
 
 	// Find description tags.
 	ps.forEachNode(metaElements, func(element *html.Node, _ int) {
 		elementName := dom.GetAttribute(element, "name")
 		elementProperty := dom.GetAttribute(element, "property")
+		elementHTTPEquiv := dom.GetAttribute(element, "http-equiv")
 		content := dom.GetAttribute(element, "content")
 		if content == "" {
 			return
 		}
+
+		// A property may contain several space separated names
+		for _, property := range strings.Fields(elementProperty) {
+			allMetadata.add(property, content, MetadataProperty)
+		}
+		allMetadata.add(strings.Replace(elementName, ".", ":", -1), content, MetadataName)
+		allMetadata.add(elementHTTPEquiv, content, MetadataHTTPEquiv)
+
 		matches := []string{}
 		name := ""
 
@@ -1469,7 +1628,8 @@ func (ps *Parser) getArticleMetadata(jsonLd map[string]string) map[string]string
 	metadataImage := strOr(
 		values["og:image"],
 		values["image"],
-		values["twitter:image"])
+		values["twitter:image"],
+		jsonLd["image"])
 
 	// get favicon
 	metadataFavicon := ps.getArticleFavicon()
@@ -1500,6 +1660,11 @@ func (ps *Parser) getArticleMetadata(jsonLd map[string]string) map[string]string
 	metadataPublishedTime = shtml.UnescapeString(metadataPublishedTime)
 	metadataModifiedTime = shtml.UnescapeString(metadataModifiedTime)
 
+	// The JSON-LD values come after the <meta> ones
+	for key, values := range jsonLdMetadata {
+		allMetadata[key] = append(allMetadata[key], values...)
+	}
+
 	return map[string]string{
 		"title":         metadataTitle,
 		"byline":        metadataByline,
@@ -1509,7 +1674,14 @@ func (ps *Parser) getArticleMetadata(jsonLd map[string]string) map[string]string
 		"favicon":       metadataFavicon,
 		"publishedTime": metadataPublishedTime,
 		"modifiedTime":  metadataModifiedTime,
-	}
+
+		// These are only found in JSON-LD
+		"section":           jsonLd["section"],
+		"language":          jsonLd["language"],
+		"wordCount":         jsonLd["wordCount"],
+		"accessibleForFree": jsonLd["accessibleForFree"],
+		"mainEntityOfPage":  jsonLd["mainEntityOfPage"],
+	}, allMetadata
 }
 
 // isSingleImage checks if node is image, or if node contains exactly
@@ -1732,7 +1904,7 @@ func (ps *Parser) getLinkDensity(element *html.Node) float64 {
 // getClassWeight gets an elements class/id weight. Uses regular
 // expressions to tell if this element looks good or bad.
 func (ps *Parser) getClassWeight(node *html.Node) int {
-	if !ps.flags.useWeightClasses {
+	if !ps.flags.useWeightClasses || ps.Scoring.DisableClassWeight {
 		return 0
 	}
 
@@ -1740,23 +1912,23 @@ func (ps *Parser) getClassWeight(node *html.Node) int {
 
 	// Look for a special classname
 	if nodeClassName := dom.ClassName(node); nodeClassName != "" {
-		if RxNegative.MatchString(nodeClassName) {
-			weight -= 25
+		if ps.Scoring.Negative.MatchString(nodeClassName) {
+			weight -= ps.Scoring.ClassWeight
 		}
 
-		if RxPositive.MatchString(nodeClassName) {
-			weight += 25
+		if ps.Scoring.Positive.MatchString(nodeClassName) {
+			weight += ps.Scoring.ClassWeight
 		}
 	}
 
 	// Look for a special ID
 	if nodeID := dom.ID(node); nodeID != "" {
-		if RxNegative.MatchString(nodeID) {
-			weight -= 25
+		if ps.Scoring.Negative.MatchString(nodeID) {
+			weight -= ps.Scoring.ClassWeight
 		}
 
-		if RxPositive.MatchString(nodeID) {
-			weight += 25
+		if ps.Scoring.Positive.MatchString(nodeID) {
+			weight += ps.Scoring.ClassWeight
 		}
 	}
 
@@ -1994,16 +2166,27 @@ func (ps *Parser) fixLazyImages(root *html.Node) {
 // cleanConditionally cleans an element of all tags of type "tag" if
 // they look fishy. "Fishy" is an algorithm based on content length,
 // classnames, link density, number of images & embeds, etc.
-func (ps *Parser) cleanConditionally(element *html.Node, tag string) {
+// Once the context is cancelled, the remaining nodes are kept as they
+// are and ctx.Err() is returned.
+func (ps *Parser) cleanConditionally(ctx context.Context, element *html.Node, tag string) error {
 	if !ps.flags.cleanConditionally {
-		return
+		return nil
 	}
 
 	// Gather counts for other typical elements embedded within.
 	// Traverse backwards so we can remove nodes at the same time
 	// without effecting the traversal.
 	// TODO: Consider taking into account original contentScore here.
+	var err error
 	ps.removeNodes(dom.GetElementsByTagName(element, tag), func(node *html.Node) bool {
+		if err != nil {
+			return false
+		}
+
+		if err = ctx.Err(); err != nil {
+			return false
+		}
+
 		// First check if this node IS data table, in which case don't remove it.
 		if tag == "table" && ps.isReadabilityDataTable(node) {
 			return false
@@ -2097,6 +2280,8 @@ func (ps *Parser) cleanConditionally(element *html.Node, tag string) {
 
 		return false
 	})
+
+	return err
 }
 
 // cleanMatchedNodes cleans out elements whose id/class
@@ -2119,7 +2304,7 @@ func (ps *Parser) cleanHeaders(e *html.Node) {
 	ps.removeNodes(headingNodes, func(node *html.Node) bool {
 		// Removing header with low class weight
 		if ps.getClassWeight(node) < 0 {
-			ps.logf("removing header with low class weight: %q\n", dom.OuterHTML(node))
+			ps.logDebug("removing header with low class weight", "node", logNode{node})
 			return true
 		}
 		return false
@@ -2134,7 +2319,7 @@ func (ps *Parser) headerDuplicatesTitle(node *html.Node) bool {
 	}
 
 	heading := ps.getInnerText(node, false)
-	ps.logf("evaluating similarity of header: %q and %q\n", heading, ps.articleTitle)
+	ps.logDebug("evaluating similarity of header", "heading", heading, "title", ps.articleTitle)
 	return ps.textSimilarity(ps.articleTitle, heading) > 0.75
 }
 
@@ -2200,7 +2385,56 @@ func (ps *Parser) getArticleFavicon() string {
 		}
 	})
 
-	return toAbsoluteURI(favicon, ps.documentURI)
+	return ps.toAbsoluteURI(favicon)
+}
+
+// getArticleLinks returns the canonical URL, the AMP URL and the hreflang
+// alternates of the document, made absolute with toAbsoluteURI, so they're
+// resolved against <base href> if there is one. The canonical URL is read
+// from <link rel="canonical">, or from og:url if there is no such link.
+func (ps *Parser) getArticleLinks() (canonicalURL, ampURL string, alternates []AlternateLink) {
+	linkElements := dom.GetElementsByTagName(ps.doc, "link")
+	ps.forEachNode(linkElements, func(link *html.Node, _ int) {
+		linkHref := strings.TrimSpace(dom.GetAttribute(link, "href"))
+		if linkHref == "" {
+			return
+		}
+
+		for _, rel := range strings.Fields(strings.ToLower(dom.GetAttribute(link, "rel"))) {
+			switch rel {
+			case "canonical":
+				if canonicalURL == "" {
+					canonicalURL = ps.toAbsoluteURI(linkHref)
+				}
+
+			case "amphtml":
+				if ampURL == "" {
+					ampURL = ps.toAbsoluteURI(linkHref)
+				}
+
+			case "alternate":
+				if hreflang := strings.TrimSpace(dom.GetAttribute(link, "hreflang")); hreflang != "" {
+					alternates = append(alternates, AlternateLink{
+						HrefLang: hreflang,
+						URL:      ps.toAbsoluteURI(linkHref),
+					})
+				}
+			}
+		}
+	})
+
+	if canonicalURL == "" {
+		metaElements := dom.GetElementsByTagName(ps.doc, "meta")
+		ps.forEachNode(metaElements, func(meta *html.Node, _ int) {
+			property := strings.ToLower(dom.GetAttribute(meta, "property"))
+			content := strings.TrimSpace(dom.GetAttribute(meta, "content"))
+			if canonicalURL == "" && content != "" && indexOf(strings.Fields(property), "og:url") >= 0 {
+				canonicalURL = ps.toAbsoluteURI(content)
+			}
+		})
+	}
+
+	return canonicalURL, ampURL, alternates
 }
 
 // removeComments find all comments in document then remove it.
@@ -2237,65 +2471,80 @@ func (ps *Parser) removeComments(doc *html.Node) {
 // only used for layout.
 //
 // However, since Go is static typed, we can't do it that way.
-// As workaround, we just saved those data as attribute in the
-// HTML nodes. Hence why these methods exists.
+// As workaround, we keep those data in a side table that maps the
+// HTML nodes to their state, which is reset for each attempt to grab
+// the article. Hence why these methods exists.
+
+// nodeState is the state of a node while grabbing the article.
+type nodeState struct {
+	contentScore    float64
+	hasContentScore bool
+	isDataTable     bool
+}
 
 // setReadabilityDataTable marks whether a Node is data table or not.
 func (ps *Parser) setReadabilityDataTable(node *html.Node, isDataTable bool) {
-	if isDataTable {
-		dom.SetAttribute(node, "data-readability-table", "true")
-	} else {
-		dom.RemoveAttribute(node, "data-readability-table")
-	}
+	state := ps.nodeStates[node]
+	state.isDataTable = isDataTable
+	ps.nodeStates[node] = state
 }
 
 // isReadabilityDataTable determines if node is data table.
 func (ps *Parser) isReadabilityDataTable(node *html.Node) bool {
-	return dom.HasAttribute(node, "data-readability-table")
+	return ps.nodeStates[node].isDataTable
 }
 
-// setContentScore sets the readability score for a node.
+// setContentScore sets the readability score for a node. The score is
+// rounded to 4 decimals, like it was when the scores were stored in the
+// attributes of the nodes, because some of the ratios of the scores are
+// compared with their thresholds exactly.
 func (ps *Parser) setContentScore(node *html.Node, score float64) {
-	dom.SetAttribute(node, "data-readability-score", fmt.Sprintf("%.4f", score))
+	state := ps.nodeStates[node]
+	state.contentScore = math.Round(score*1e4) / 1e4
+	state.hasContentScore = true
+	ps.nodeStates[node] = state
 }
 
 // hasContentScore checks if node has readability score.
 func (ps *Parser) hasContentScore(node *html.Node) bool {
-	return dom.HasAttribute(node, "data-readability-score")
+	return ps.nodeStates[node].hasContentScore
 }
 
 // getContentScore gets the readability score of a node.
 func (ps *Parser) getContentScore(node *html.Node) float64 {
-	strScore := dom.GetAttribute(node, "data-readability-score")
-	strScore = strings.TrimSpace(strScore)
-	if strScore == "" {
-		return 0
-	}
-
-	score, _ := strconv.ParseFloat(strScore, 64)
-	return score
+	return ps.nodeStates[node].contentScore
 }
 
 // clearReadabilityAttr removes Readability attribute that
 // created by this package. Used in `postProcessContent`.
 func (ps *Parser) clearReadabilityAttr(node *html.Node) {
-	dom.RemoveAttribute(node, "data-readability-score")
-	dom.RemoveAttribute(node, "data-readability-table")
+	dom.RemoveAttribute(node, debugIDAttr)
 
 	for child := dom.FirstElementChild(node); child != nil; child = dom.NextElementSibling(child) {
 		ps.clearReadabilityAttr(child)
 	}
 }
 
-func (ps *Parser) log(args ...interface{}) {
-	if ps.Debug {
-		log.Println(args...)
+// debugLogger is the logger used when Debug is enabled without a Logger.
+var debugLogger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+// logger returns the logger of the parser, or nil if logging is disabled.
+func (ps *Parser) logger() *slog.Logger {
+	switch {
+	case ps.Logger != nil:
+		return ps.Logger
+	case ps.Debug:
+		return debugLogger
+	default:
+		return nil
 	}
 }
 
-func (ps *Parser) logf(format string, args ...interface{}) {
-	if ps.Debug {
-		log.Printf(format, args...)
+// logDebug writes a record at debug level, with the key/value pairs in
+// args as its attributes.
+func (ps *Parser) logDebug(msg string, args ...any) {
+	if logger := ps.logger(); logger != nil {
+		logger.Debug(msg, args...)
 	}
 }
 
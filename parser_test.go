@@ -1,12 +1,22 @@
 package readability
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
+	"math"
 	"net/url"
 	"os"
 	fp "path/filepath"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"runtime/metrics"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -24,6 +34,7 @@ type ExpectedMetadata struct {
 	Byline        string `json:"byline,omitempty"`
 	Excerpt       string `json:"excerpt,omitempty"`
 	Language      string `json:"language,omitempty"`
+	Dir           string `json:"dir,omitempty"`
 	SiteName      string `json:"siteName,omitempty"`
 	Readerable    bool   `json:"readerable"`
 	PublishedTime string `json:"publishedTime,omitempty"`
@@ -98,6 +109,10 @@ func Test_parser(t *testing.T) {
 				t1.Errorf("language, want %q got %q\n", metadata.Language, article.Language)
 			}
 
+			if metadata.Dir != article.Dir {
+				t1.Errorf("dir, want %q got %q\n", metadata.Dir, article.Dir)
+			}
+
 			if !timesAreEqual(metadata.PublishedTime, article.PublishedTime) {
 				t1.Errorf("date published, want %q got %q\n", metadata.PublishedTime, article.PublishedTime)
 			}
@@ -125,8 +140,10 @@ func extractSourceFile(path string) (Article, *html.Node, *html.Node, error) {
 	}
 
 	// Extract readable article
+	// Some test pages are shorter than CharThresholds, but the best
+	// attempt is still expected to be returned along with ErrNoContent.
 	article, err := FromDocument(originalDoc, fakeHostURL)
-	if err != nil {
+	if err != nil && !errors.Is(err, ErrNoContent) {
 		return Article{}, nil, nil, fmt.Errorf("failed to extract source: %v", err)
 	}
 
@@ -277,3 +294,520 @@ func timesAreEqual(metadataTimeString string, parsedTime *time.Time) bool {
 	metadataTime := ps.getParsedDate(metadataTimeString)
 	return metadataTime.Equal(*parsedTime)
 }
+
+func Test_parseContextCancelled(t *testing.T) {
+	f, err := os.Open(fp.Join("test-pages", "wikipedia", "source.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	parser := NewParser()
+	_, err = parser.ParseContext(ctx, f, fakeHostURL)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("want %v got %v\n", context.Canceled, err)
+	}
+}
+
+func Test_grabArticleCancelled(t *testing.T) {
+	f, err := os.Open(fp.Join("test-pages", "wikipedia", "source.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	doc, err := dom.Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	<-ctx.Done()
+
+	parser := NewParser()
+	parser.doc = doc
+	parser.flags = flags{stripUnlikelys: true, useWeightClasses: true, cleanConditionally: true}
+	if _, err := parser.grabArticle(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("want %v got %v\n", context.DeadlineExceeded, err)
+	}
+}
+
+func Test_grabArticleRetries(t *testing.T) {
+	testItems, err := os.ReadDir("test-pages")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The sidebar is only stripped by the first attempt. Its <br>s are
+	// turned into a <p> nested in another <p>, which can't be restored
+	// from HTML, since the HTML parser never nests them.
+	paragraph := "<p>This paragraph is too short for the article to be found on the first attempt.</p>"
+	sources := map[string]string{
+		"nested paragraphs": `<html><body><div class="sidebar"><p><span>The first line of the sidebar, with some text.<br><br>The second line of the sidebar, with some more text.</span></p></div>
+			<div class="content">` + strings.Repeat(paragraph, 3) + `</div></body></html>`,
+	}
+
+	for _, item := range testItems {
+		if !item.IsDir() {
+			continue
+		}
+
+		source, err := os.ReadFile(fp.Join("test-pages", item.Name(), "source.html"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sources[item.Name()] = string(source)
+	}
+
+	// grabArticle runs every attempt on the document of source, prepared
+	// like Parse does, starting with the given flags and byline.
+	grabArticle := func(t *testing.T, source string, initial flags, byline string) ([]TraceAttempt, string) {
+		doc, err := html.Parse(strings.NewReader(source))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		parser := NewParser()
+		parser.CharThresholds = math.MaxInt
+		parser.doc = doc
+		parser.flags = initial
+		parser.articleByline = byline
+		parser.trace = &Trace{FinalAttempt: -1}
+		parser.removeScripts(parser.doc)
+		parser.prepDocument()
+		if _, err := parser.grabArticle(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		return parser.trace.Attempts, parser.articleByline
+	}
+
+	// Each retry must see the same document as an attempt that starts
+	// from a fresh copy of the page with the same flags. The byline is
+	// only looked for until it's found, so the fresh attempt gets the
+	// byline found by the previous attempts, if any.
+	for name, source := range sources {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			attempts, byline := grabArticle(t, source, flags{stripUnlikelys: true, useWeightClasses: true, cleanConditionally: true}, "")
+			knownByline := ""
+			for i := 1; i < len(attempts); i++ {
+				for _, removal := range attempts[i-1].Removed {
+					if removal.Reason == RemovalByline {
+						knownByline = byline
+					}
+				}
+
+				expected, _ := grabArticle(t, source, flags{
+					stripUnlikelys:     attempts[i].StripUnlikelys,
+					useWeightClasses:   attempts[i].UseWeightClasses,
+					cleanConditionally: attempts[i].CleanConditionally,
+				}, knownByline)
+
+				if !reflect.DeepEqual(attempts[i], expected[0]) {
+					t.Errorf("attempt %d is different from a fresh attempt with the same flags", i)
+				}
+			}
+		})
+	}
+}
+
+func Test_parseErrors(t *testing.T) {
+	scenarios := map[string]struct {
+		source          string
+		maxElemsToParse int
+		want            error
+	}{
+		"no content": {
+			source: "<html><body><p>Too short.</p></body></html>",
+			want:   ErrNoContent,
+		},
+		"no body": {
+			source: "<html><head><title>Nothing</title></head></html>",
+			want:   ErrNoContent,
+		},
+		"too many elements": {
+			source:          "<html><body><div><p>One</p><p>Two</p></div></body></html>",
+			maxElemsToParse: 4,
+			want:            ErrTooManyElements,
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t1 *testing.T) {
+			parser := NewParser()
+			parser.MaxElemsToParse = scenario.maxElemsToParse
+			_, err := parser.Parse(strings.NewReader(scenario.source), fakeHostURL)
+			if !errors.Is(err, scenario.want) {
+				t1.Errorf("want %v got %v\n", scenario.want, err)
+			}
+		})
+	}
+
+	parser := NewParser()
+	parser.MaxElemsToParse = 4
+	_, err := parser.Parse(strings.NewReader("<div><p>One</p><p>Two</p></div>"), fakeHostURL)
+
+	var tooMany *TooManyElementsError
+	if !errors.As(err, &tooMany) || tooMany.Count != 6 || tooMany.Max != 4 {
+		t.Errorf("want *TooManyElementsError with 6 elements got %v\n", err)
+	}
+}
+
+func Test_getArticleLinks(t *testing.T) {
+	page := `<html><head>
+		<link rel="canonical" href="/articles/canonical.html">
+		<link rel="AmpHTML" href="https://amp.fakehost/articles/canonical.html">
+		<link rel="alternate" hreflang="da" href="/da/articles/canonical.html">
+		<link rel="alternate" hreflang="x-default" href="https://fakehost/articles/canonical.html">
+		<link rel="alternate" type="application/rss+xml" href="/feed.xml">
+		<meta property="og:url" content="https://fakehost/og-url.html">
+	</head><body><article><p>` + strings.Repeat("Lorem ipsum dolor sit amet. ", 30) + `<a href="other.html">Link</a></p></article></body></html>`
+
+	parser := NewParser()
+	article, err := parser.Parse(strings.NewReader(page), fakeHostURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "http://fakehost/articles/canonical.html"; article.CanonicalURL != want {
+		t.Errorf("canonical URL, want %q got %q", want, article.CanonicalURL)
+	}
+
+	if want := "https://amp.fakehost/articles/canonical.html"; article.AMPURL != want {
+		t.Errorf("AMP URL, want %q got %q", want, article.AMPURL)
+	}
+
+	wantAlternates := []AlternateLink{
+		{HrefLang: "da", URL: "http://fakehost/da/articles/canonical.html"},
+		{HrefLang: "x-default", URL: "https://fakehost/articles/canonical.html"},
+	}
+	if fmt.Sprint(article.Alternates) != fmt.Sprint(wantAlternates) {
+		t.Errorf("alternates, want %v got %v", wantAlternates, article.Alternates)
+	}
+
+	// Without page URL, og:url is the only absolute canonical URL
+	page = strings.Replace(page, `<link rel="canonical" href="/articles/canonical.html">`, "", 1)
+	parser.UseCanonicalURL = true
+	article, err = parser.Parse(strings.NewReader(page), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "https://fakehost/og-url.html"; article.CanonicalURL != want {
+		t.Errorf("canonical URL, want %q got %q", want, article.CanonicalURL)
+	}
+
+	if want := `href="https://fakehost/other.html"`; !strings.Contains(article.Content, want) {
+		t.Errorf("relative link should be resolved against canonical URL, got %q", article.Content)
+	}
+}
+
+func Test_getBaseURI(t *testing.T) {
+	page := `<html><head>
+		<base href="/static/">
+		<link rel="icon" type="image/png" href="favicon.png">
+	</head><body><article><p>` + strings.Repeat("Lorem ipsum dolor sit amet. ", 30) + `</p>
+		<p><a href="other.html">Link</a> <a href="#top">Top</a></p>
+		<img src="image.png" srcset="image-2x.png 2x, image-3x.png 3x">
+		<video poster="poster.png"></video>
+	</article></body></html>`
+
+	scenarios := map[string]struct {
+		disableBaseElement bool
+		baseURL            string
+		hashLink           string
+	}{
+		"base element": {
+			baseURL:  "http://fakehost/static/",
+			hashLink: "http://fakehost/static/#top",
+		},
+		"base element disabled": {
+			disableBaseElement: true,
+			baseURL:            "http://fakehost/test/",
+			hashLink:           "#top",
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t1 *testing.T) {
+			parser := NewParser()
+			parser.DisableBaseElement = scenario.disableBaseElement
+			article, err := parser.Parse(strings.NewReader(page), fakeHostURL)
+			if err != nil {
+				t1.Fatal(err)
+			}
+
+			if want := scenario.baseURL + "favicon.png"; article.Favicon != want {
+				t1.Errorf("favicon, want %q got %q", want, article.Favicon)
+			}
+
+			for _, want := range []string{
+				`href="` + scenario.baseURL + `other.html"`,
+				`href="` + scenario.hashLink + `"`,
+				`src="` + scenario.baseURL + `image.png"`,
+				`srcset="` + scenario.baseURL + `image-2x.png 2x, ` + scenario.baseURL + `image-3x.png 3x"`,
+				`poster="` + scenario.baseURL + `poster.png"`,
+			} {
+				if !strings.Contains(article.Content, want) {
+					t1.Errorf("content should contain %s, got %q", want, article.Content)
+				}
+			}
+		})
+	}
+}
+
+func Test_getTextDirection(t *testing.T) {
+	scenarios := map[string]string{
+		"Lorem ipsum dolor sit amet.":                 "",
+		"שלום עולם, זהו מאמר בעברית עם מילה English.": "rtl",
+		"مرحبا بالعالم 2024":                          "rtl",
+		"Hello world مرحبا بالعالم":                   "",
+		"1234 - !?":                                   "",
+	}
+
+	parser := NewParser()
+	for text, expected := range scenarios {
+		if dir := parser.getTextDirection(text); dir != expected {
+			t.Errorf("text %q, want %q got %q", text, expected, dir)
+		}
+	}
+}
+
+func Test_Logger(t *testing.T) {
+	page := `<html><body>
+		<div class="sidebar">Related links</div>
+		<article><p>` + strings.Repeat("Lorem ipsum dolor sit amet, consectetur adipiscing elit. ", 20) + `</p></article>
+	</body></html>`
+
+	var buf bytes.Buffer
+	parser := NewParser()
+	parser.Logger = slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	if _, err := parser.Parse(strings.NewReader(page), fakeHostURL); err != nil {
+		t.Fatal(err)
+	}
+
+	type record struct {
+		Msg    string
+		Reason string
+		Match  string
+		Score  float64
+		Node   struct{ Path string }
+	}
+
+	var removal, candidate *record
+	decoder := json.NewDecoder(&buf)
+	for decoder.More() {
+		var r record
+		if err := decoder.Decode(&r); err != nil {
+			t.Fatal(err)
+		}
+
+		switch {
+		case r.Msg == "removing node" && r.Reason == string(RemovalUnlikelyCandidate):
+			removal = &r
+		case r.Msg == "scored candidate" && r.Node.Path == "html > body > article":
+			candidate = &r
+		}
+	}
+
+	if removal == nil || removal.Node.Path != "html > body > div.sidebar" || removal.Match != "sidebar " {
+		t.Errorf("unlikely candidate should be logged, got %+v", removal)
+	}
+
+	if candidate == nil || candidate.Score <= 0 {
+		t.Errorf("candidate should be logged with its score, got %+v", candidate)
+	}
+
+	// Nothing is logged at higher levels
+	buf.Reset()
+	parser.Logger = slog.New(slog.NewJSONHandler(&buf, nil))
+	if _, err := parser.Parse(strings.NewReader(page), fakeHostURL); err != nil {
+		t.Fatal(err)
+	}
+
+	if buf.Len() != 0 {
+		t.Errorf("want no records at info level, got %s", buf.String())
+	}
+}
+
+func Benchmark_parser(b *testing.B) {
+	testItems, err := os.ReadDir("test-pages")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var docs []*html.Node
+	for _, item := range testItems {
+		if !item.IsDir() {
+			continue
+		}
+
+		doc, err := parseTestPage(fp.Join("test-pages", item.Name(), "source.html"))
+		if err != nil {
+			b.Fatal(err)
+		}
+		docs = append(docs, doc)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, doc := range docs {
+			parser := NewParser()
+			_, _ = parser.ParseDocument(doc, fakeHostURL)
+		}
+	}
+}
+
+func Benchmark_parserLargePages(b *testing.B) {
+	for _, name := range []string{"wikipedia", "wikipedia-2", "nytimes-3", "nytimes-5"} {
+		path := fp.Join("test-pages", name, "source.html")
+		source, err := os.ReadFile(path)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		doc, err := parseTestPage(path)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		// Parse owns the document it builds, while ParseDocument has to
+		// clone the document of the caller.
+		b.Run(name+"/Parse", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				parser := NewParser()
+				_, _ = parser.Parse(bytes.NewReader(source), fakeHostURL)
+			}
+		})
+
+		b.Run(name+"/ParseDocument", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				parser := NewParser()
+				_, _ = parser.ParseDocument(doc, fakeHostURL)
+			}
+		})
+	}
+}
+
+// Benchmark_parserPeakHeap reports the peak of the live heap while a page
+// is parsed, which is what limits the size of the pages that can be parsed
+// concurrently. The pages are the large ones, and the ones that need more
+// than one attempt to grab the article.
+func Benchmark_parserPeakHeap(b *testing.B) {
+	for _, name := range []string{"wikipedia", "nytimes-5", "cnn", "aclu", "firefox-nightly-blog", "hukumusume"} {
+		source, err := os.ReadFile(fp.Join("test-pages", name, "source.html"))
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(name, func(b *testing.B) {
+			var total uint64
+			for i := 0; i < b.N; i++ {
+				total += peakLiveHeap(func() {
+					parser := NewParser()
+					_, _ = parser.Parse(bytes.NewReader(source), fakeHostURL)
+				})
+			}
+			b.ReportMetric(float64(total)/float64(b.N), "peak-live-B/op")
+		})
+	}
+}
+
+// peakLiveHeap runs fn and returns the peak of the live heap while it runs,
+// above the live heap before it. The live heap is only known after each
+// garbage collection, so the collector is made to run very often.
+func peakLiveHeap(fn func()) uint64 {
+	defer debug.SetGCPercent(debug.SetGCPercent(1))
+	runtime.GC()
+
+	samples := []metrics.Sample{{Name: "/gc/heap/live:bytes"}}
+	metrics.Read(samples)
+	base := samples[0].Value.Uint64()
+
+	done, sampled := make(chan struct{}), make(chan uint64)
+	go func() {
+		var peak uint64
+		samples := []metrics.Sample{{Name: "/gc/heap/live:bytes"}}
+		for {
+			metrics.Read(samples)
+			peak = max(peak, samples[0].Value.Uint64())
+
+			select {
+			case <-done:
+				sampled <- peak
+				return
+			case <-time.After(50 * time.Microsecond):
+			}
+		}
+	}()
+
+	fn()
+	close(done)
+	peak := <-sampled
+	if peak < base {
+		return 0
+	}
+	return peak - base
+}
+
+func parseTestPage(path string) (*html.Node, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return html.Parse(f)
+}
+
+func Test_parserConcurrent(t *testing.T) {
+	testItems, err := os.ReadDir("test-pages")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A single parser is shared by all the goroutines, so the race
+	// detector catches any state that isn't kept per parse.
+	parser := NewParser()
+	parser.Trace = true
+
+	var names []string
+	var docs []*html.Node
+	expected := make(map[string]string)
+	for _, item := range testItems {
+		if !item.IsDir() {
+			continue
+		}
+
+		doc, err := parseTestPage(fp.Join("test-pages", item.Name(), "source.html"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		article, _ := parser.ParseDocument(doc, fakeHostURL)
+		names = append(names, item.Name())
+		docs = append(docs, doc)
+		expected[item.Name()] = article.Content
+	}
+
+	var wg sync.WaitGroup
+	for i := range docs {
+		wg.Add(1)
+		go func(name string, doc *html.Node) {
+			defer wg.Done()
+			article, _ := parser.ParseDocument(doc, fakeHostURL)
+			if article.Content != expected[name] {
+				t.Errorf("%s: content is different when parsed concurrently", name)
+			}
+		}(names[i], docs[i])
+	}
+	wg.Wait()
+}
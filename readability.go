@@ -9,78 +9,64 @@
 package readability
 
 import (
-	"fmt"
+	"context"
 	"io"
 	"net/http"
 	nurl "net/url"
-	"strings"
 	"time"
 
 	"golang.org/x/net/html"
 )
 
+// defaultParser is the parser used by the package level functions. It's
+// shared by all of them, since a Parser can be used concurrently, so
+// there's no need for a pool of parsers.
+var defaultParser = NewParser()
+
 // FromReader parses an `io.Reader` and returns the readable content. It's the wrapper
 // or `Parser.Parse()` and useful if you only want to use the default parser.
 func FromReader(input io.Reader, pageURL *nurl.URL) (Article, error) {
-	parser := NewParser()
-	return parser.Parse(input, pageURL)
+	return defaultParser.Parse(input, pageURL)
 }
 
 // FromDocument parses an document and returns the readable content. It's the wrapper
 // or `Parser.ParseDocument()` and useful if you only want to use the default parser.
 func FromDocument(doc *html.Node, pageURL *nurl.URL) (Article, error) {
-	parser := NewParser()
-	return parser.ParseDocument(doc, pageURL)
+	return defaultParser.ParseDocument(doc, pageURL)
 }
 
+// RequestWith modifies the request that is sent by FromURL, e.g. to set
+// a custom user agent or cookies.
 type RequestWith func(r *http.Request)
 
 // FromURL fetch the web page from specified url then parses the response to find
-// the readable content.
+// the readable content. A zero timeout means no timeout.
 func FromURL(pageURL string, timeout time.Duration, requestModifiers ...RequestWith) (Article, error) {
-	// Make sure URL is valid
-	parsedURL, err := nurl.ParseRequestURI(pageURL)
-	if err != nil {
-		return Article{}, fmt.Errorf("failed to parse URL: %v", err)
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
 	}
 
-	// Fetch page from URL
-	client := &http.Client{Timeout: timeout}
-	req, err := http.NewRequest("GET", pageURL, nil)
-	for _, modifer := range requestModifiers {
-		modifer(req)
-	}
-	if err != nil {
-		return Article{}, fmt.Errorf("failed to fetch the page: %v", err)
-	}
-	resp, err := client.Do(req)
-	if err != nil {
-		return Article{}, fmt.Errorf("failed to fetch the page: %v", err)
-	}
-	defer resp.Body.Close()
-
-	// Make sure content type is HTML
-	cp := resp.Header.Get("Content-Type")
-	if !strings.Contains(cp, "text/html") {
-		return Article{}, fmt.Errorf("URL is not a HTML document")
-	}
+	return FromURLContext(ctx, pageURL, requestModifiers...)
+}
 
-	// Parse content
-	parser := NewParser()
-	return parser.Parse(resp.Body, parsedURL)
+// FromURLContext is like FromURL, but uses ctx for both the HTTP request and the
+// parsing instead of a fixed timeout.
+func FromURLContext(ctx context.Context, pageURL string, requestModifiers ...RequestWith) (Article, error) {
+	return defaultParser.ParseURL(ctx, pageURL, NewHTTPFetcher(nil, requestModifiers...))
 }
 
 // Check checks whether the input is readable without parsing the whole thing. It's the
 // wrapper for `Parser.Check()` and useful if you only use the default parser.
 func Check(input io.Reader) bool {
-	parser := NewParser()
-	return parser.Check(input)
+	return defaultParser.Check(input)
 }
 
 // CheckDocument checks whether the document is readable without parsing the whole thing.
 // It's the wrapper for `Parser.CheckDocument()` and useful if you only use the default
 // parser.
 func CheckDocument(doc *html.Node) bool {
-	parser := NewParser()
-	return parser.CheckDocument(doc)
+	return defaultParser.CheckDocument(doc)
 }
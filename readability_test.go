@@ -0,0 +1,189 @@
+package readability
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	fp "path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func serveTestPage(name, contentType string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		http.ServeFile(w, r, fp.Join("test-pages", name, "source.html"))
+	}
+}
+
+func Test_FromURLContext(t *testing.T) {
+	server := newTestServer(t, serveTestPage("wikipedia", "text/html; charset=utf-8"))
+
+	article, err := FromURLContext(context.Background(), server.URL+"/page.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "Mozilla - Wikipedia"; article.Title != want {
+		t.Errorf("title, want %q got %q\n", want, article.Title)
+	}
+}
+
+func Test_ParseURLWithFetcher(t *testing.T) {
+	server := newTestServer(t, serveTestPage("wikipedia", "text/html"))
+
+	// Use the client of the test server
+	parser := NewParser()
+	_, err := parser.ParseURL(context.Background(), server.URL, NewHTTPFetcher(server.Client()))
+	if err != nil {
+		t.Error(err)
+	}
+
+	// Use a recorded response without any network access
+	var fetchedURL string
+	recorded := FetcherFunc(func(ctx context.Context, pageURL string) (*http.Response, error) {
+		fetchedURL = pageURL
+		f, err := os.Open(fp.Join("test-pages", "wikipedia", "source.html"))
+		if err != nil {
+			return nil, err
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"text/html"}},
+			Body:       f,
+		}, nil
+	})
+
+	_, err = parser.ParseURL(context.Background(), "http://fakehost/test/page.html", recorded)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if fetchedURL != "http://fakehost/test/page.html" {
+		t.Errorf("fetched URL, want %q got %q\n", "http://fakehost/test/page.html", fetchedURL)
+	}
+}
+
+func Test_ParseURLErrors(t *testing.T) {
+	failingFetcher := FetcherFunc(func(ctx context.Context, pageURL string) (*http.Response, error) {
+		return nil, io.ErrUnexpectedEOF
+	})
+
+	jsonFetcher := FetcherFunc(func(ctx context.Context, pageURL string) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader("{}")),
+		}, nil
+	})
+
+	parser := NewParser()
+	if _, err := parser.ParseURL(context.Background(), "not a url", nil); !errors.Is(err, ErrInvalidURL) {
+		t.Errorf("invalid URL, want %v got %v\n", ErrInvalidURL, err)
+	}
+
+	_, err := parser.ParseURL(context.Background(), "http://fakehost/", failingFetcher)
+	if !errors.Is(err, ErrFetch) || !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("fetch, want %v got %v\n", ErrFetch, err)
+	}
+
+	_, err = parser.ParseURL(context.Background(), "http://fakehost/", jsonFetcher)
+	var notHTML *NotHTMLError
+	if !errors.As(err, &notHTML) || notHTML.ContentType != "application/json" {
+		t.Errorf("not HTML, want %v got %v\n", ErrNotHTML, err)
+	}
+}
+
+func Test_ParseURLHardening(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = io.WriteString(w, "<html><body><p>Not found</p></body></html>")
+	})
+	mux.HandleFunc("/loop", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/loop", http.StatusFound)
+	})
+	mux.HandleFunc("/old/page.html", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/new/page.html", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/new/page.html", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		paragraph := strings.Repeat("Lorem ipsum dolor sit amet, consectetur adipiscing elit. ", 10)
+		_, _ = io.WriteString(w, "<html><body><article>"+
+			"<p>"+paragraph+"</p><p>"+paragraph+`<a href="other.html">more</a></p>`+
+			"</article></body></html>")
+	})
+	mux.HandleFunc("/chunked", func(w http.ResponseWriter, r *http.Request) {
+		// Flushing before the end sends the body without Content-Length
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = io.WriteString(w, "<html><body>")
+		w.(http.Flusher).Flush()
+		_, _ = io.WriteString(w, strings.Repeat("<p>Lorem ipsum dolor sit amet.</p>", 100)+"</body></html>")
+	})
+	server := newTestServer(t, mux.ServeHTTP)
+
+	ctx := context.Background()
+	parser := NewParser()
+	fetcher := NewHTTPFetcher(server.Client())
+	fetcher.MaxRedirects = 3
+
+	var fetchErr *FetchError
+	_, err := parser.ParseURL(ctx, server.URL+"/missing", fetcher)
+	if !errors.As(err, &fetchErr) || fetchErr.StatusCode != http.StatusNotFound {
+		t.Errorf("status, want status %d got %v\n", http.StatusNotFound, err)
+	}
+
+	_, err = parser.ParseURL(ctx, server.URL+"/loop", fetcher)
+	if !errors.Is(err, ErrTooManyRedirects) {
+		t.Errorf("redirects, want %v got %v\n", ErrTooManyRedirects, err)
+	}
+
+	// Relative links are resolved against the final URL
+	article, err := parser.ParseURL(ctx, server.URL+"/old/page.html", fetcher)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(article.Content, `href="`+server.URL+`/new/other.html"`) {
+		t.Errorf("relative links are not resolved against %s\n", server.URL+"/new/page.html")
+	}
+
+	// A negative limit disables the redirects
+	noRedirects := NewHTTPFetcher(server.Client())
+	noRedirects.MaxRedirects = -1
+	_, err = parser.ParseURL(ctx, server.URL+"/old/page.html", noRedirects)
+	if !errors.Is(err, ErrTooManyRedirects) {
+		t.Errorf("disabled redirects, want %v got %v\n", ErrTooManyRedirects, err)
+	}
+
+	// The body limit is enforced both with and without Content-Length,
+	// and fails the same way.
+	fetcher.MaxBodySize = 1024
+	for _, path := range []string{"/new/page.html", "/chunked"} {
+		_, err = parser.ParseURL(ctx, server.URL+path, fetcher)
+		if !errors.Is(err, ErrBodyTooLarge) || !errors.As(err, &fetchErr) {
+			t.Errorf("%s: body size, want %v in a *FetchError got %v\n", path, ErrBodyTooLarge, err)
+		}
+	}
+
+	body := &limitedBody{
+		ReadCloser: io.NopCloser(strings.NewReader(strings.Repeat("a", 2048))),
+		remaining:  1024,
+	}
+	content, err := io.ReadAll(body)
+	if !errors.Is(err, ErrBodyTooLarge) || len(content) != 1024 {
+		t.Errorf("streamed body size, want %v after 1024 bytes got %v after %d bytes\n",
+			ErrBodyTooLarge, err, len(content))
+	}
+}
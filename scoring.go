@@ -0,0 +1,123 @@
+package readability
+
+import (
+	"regexp"
+	"slices"
+)
+
+// ScoringRules are the patterns and weights used to find the article content.
+// The fields that are nil or zero use the value of DefaultScoringRules, so
+// only the rules that differ from the defaults have to be set.
+type ScoringRules struct {
+	// UnlikelyCandidates matches the class and id of the nodes that are
+	// unlikely to be content, e.g. "sidebar", which are removed unless
+	// OkMaybeItsACandidate matches them too. Default: RxUnlikelyCandidates
+	UnlikelyCandidates *regexp.Regexp
+	// OkMaybeItsACandidate matches the class and id of the nodes that may
+	// be content, even though UnlikelyCandidates matches them.
+	// Default: RxOkMaybeItsACandidate
+	OkMaybeItsACandidate *regexp.Regexp
+	// UnlikelyRoles are the roles of the nodes that are unlikely to be
+	// content, e.g. "navigation", which are removed.
+	// Default: menu, menubar, complementary, navigation, alert,
+	// alertdialog and dialog.
+	UnlikelyRoles []string
+	// Positive matches the class or id of the nodes that are likely to
+	// be content, which get ClassWeight added to their score.
+	// Default: RxPositive
+	Positive *regexp.Regexp
+	// Negative matches the class or id of the nodes that are unlikely to
+	// be content, which get ClassWeight subtracted from their score.
+	// Default: RxNegative
+	Negative *regexp.Regexp
+	// ClassWeight is the weight of a class or id that is matched by
+	// Positive or Negative. Since 0 means the default, use
+	// DisableClassWeight to ignore the classes and ids instead. Default: 25
+	ClassWeight int
+	// DisableClassWeight determines if the class and id of the nodes will
+	// be ignored when they are scored and cleaned, as if ClassWeight was
+	// 0. Default: false
+	DisableClassWeight bool
+	// TagScores are the initial scores of the nodes by their tag, e.g.
+	// "div" starts with 5, and headings with -5. The tags that aren't in
+	// the map start with 0. Default: see DefaultScoringRules.
+	TagScores map[string]float64
+	// Byline matches the class and id of the nodes that are the byline
+	// of the article. Default: RxByline
+	Byline *regexp.Regexp
+}
+
+// DefaultScoringRules returns the rules that are used by Readability.js.
+func DefaultScoringRules() ScoringRules {
+	return ScoringRules{
+		UnlikelyCandidates:   RxUnlikelyCandidates,
+		OkMaybeItsACandidate: RxOkMaybeItsACandidate,
+		UnlikelyRoles:        []string{"menu", "menubar", "complementary", "navigation", "alert", "alertdialog", "dialog"},
+		Positive:             RxPositive,
+		Negative:             RxNegative,
+		ClassWeight:          25,
+		TagScores: map[string]float64{
+			"div":        5,
+			"pre":        3,
+			"td":         3,
+			"blockquote": 3,
+			"address":    -3,
+			"form":       -3,
+			"h1":         -5,
+			"h2":         -5,
+			"h3":         -5,
+			"h4":         -5,
+			"h5":         -5,
+			"h6":         -5,
+			"th":         -5,
+		},
+		Byline: RxByline,
+	}
+}
+
+// defaultScoringRules are the defaults used by withDefaults. They're built
+// once and shared by all the parses, which only read them.
+var defaultScoringRules = DefaultScoringRules()
+
+// withDefaults returns the rules with the fields that aren't set replaced by
+// the defaults.
+func (rules ScoringRules) withDefaults() ScoringRules {
+	defaults := defaultScoringRules
+	if rules.UnlikelyCandidates == nil {
+		rules.UnlikelyCandidates = defaults.UnlikelyCandidates
+	}
+	if rules.OkMaybeItsACandidate == nil {
+		rules.OkMaybeItsACandidate = defaults.OkMaybeItsACandidate
+	}
+	if rules.UnlikelyRoles == nil {
+		rules.UnlikelyRoles = defaults.UnlikelyRoles
+	}
+	if rules.Positive == nil {
+		rules.Positive = defaults.Positive
+	}
+	if rules.Negative == nil {
+		rules.Negative = defaults.Negative
+	}
+	if rules.ClassWeight == 0 {
+		rules.ClassWeight = defaults.ClassWeight
+	}
+	if rules.TagScores == nil {
+		rules.TagScores = defaults.TagScores
+	}
+	if rules.Byline == nil {
+		rules.Byline = defaults.Byline
+	}
+	return rules
+}
+
+// isUnlikelyCandidate determines if the class and id in matchString look like
+// the node isn't content.
+func (rules ScoringRules) isUnlikelyCandidate(matchString string) bool {
+	return rules.UnlikelyCandidates.MatchString(matchString) &&
+		!rules.OkMaybeItsACandidate.MatchString(matchString)
+}
+
+// isUnlikelyRole determines if a node with role isn't content.
+func (rules ScoringRules) isUnlikelyRole(role string) bool {
+	return slices.Contains(rules.UnlikelyRoles, role)
+}
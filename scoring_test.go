@@ -0,0 +1,118 @@
+package readability
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func Test_ScoringRules(t *testing.T) {
+	paragraph := "<p>Vi søger en erfaren udvikler, som har lyst til at arbejde med moderne teknologier i et stærkt team, og som vil være med til at udvikle vores platform.</p>"
+	page := `<html><body>
+		<div class="artikel">` + strings.Repeat(paragraph, 2) + `
+			<div class="annonce">` + strings.Repeat(paragraph, 2) + `</div>
+			` + strings.Repeat(paragraph, 2) + `
+		</div>
+		<div class="skribent">Af Jens Hansen</div>
+	</body></html>`
+
+	// The default rules don't know the Danish class names
+	parser := NewParser()
+	parser.KeepClasses = true
+	article, err := parser.Parse(strings.NewReader(page), fakeHostURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(article.Content, "annonce") {
+		t.Errorf("default rules, want the ad in the content")
+	}
+	if article.Byline != "" {
+		t.Errorf("default rules, want no byline got %q", article.Byline)
+	}
+
+	// Only the rules that are set override the defaults
+	danish := NewParser()
+	danish.KeepClasses = true
+	danish.Scoring = ScoringRules{
+		UnlikelyCandidates: regexp.MustCompile(`(?i)annonce|reklame|kommentar`),
+		Byline:             regexp.MustCompile(`(?i)skribent|forfatter`),
+	}
+
+	article, err = danish.Parse(strings.NewReader(page), fakeHostURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(article.Content, "annonce") {
+		t.Errorf("custom rules, want the ad removed from the content")
+	}
+	if want := "Af Jens Hansen"; article.Byline != want {
+		t.Errorf("custom rules, want byline %q got %q", want, article.Byline)
+	}
+	if danish.Scoring.Positive != nil {
+		t.Errorf("the rules of the parser shouldn't be modified")
+	}
+
+	// The rules of one parser don't affect the others
+	article, _ = parser.Parse(strings.NewReader(page), fakeHostURL)
+	if !strings.Contains(article.Content, "annonce") {
+		t.Errorf("default rules after custom rules, want the ad in the content")
+	}
+}
+
+func Test_ScoringRules_weights(t *testing.T) {
+	paragraph := "<p>Vi søger en erfaren udvikler, som har lyst til at arbejde med moderne teknologier i et stærkt team, og som vil være med til at udvikle vores platform.</p>"
+	page := `<html><body><section class="artikel">` + strings.Repeat(paragraph, 4) + `
+		<div class="relateret"><p>Læs også om de andre stillinger</p><p>Se alle job</p></div>
+	` + strings.Repeat(paragraph, 2) + `</section></body></html>`
+
+	parse := func(rules ScoringRules) (float64, Article) {
+		parser := NewParser()
+		parser.Trace = true
+		parser.Scoring = rules
+		article, err := parser.Parse(strings.NewReader(page), fakeHostURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, candidate := range article.Trace.Attempts[0].Candidates {
+			if strings.HasSuffix(candidate.Node.Path, "section.artikel") {
+				return candidate.Score, article
+			}
+		}
+
+		t.Fatalf("want the section in the candidates got %v", article.Trace.Attempts[0].Candidates)
+		return 0, article
+	}
+
+	rules := ScoringRules{
+		Positive:    regexp.MustCompile(`(?i)artikel`),
+		Negative:    regexp.MustCompile(`(?i)relateret`),
+		ClassWeight: 40,
+		TagScores:   map[string]float64{"section": 2},
+	}
+
+	defaultScore, article := parse(ScoringRules{})
+	if !strings.Contains(article.TextContent, "stillinger") {
+		t.Errorf("default rules, want the related links in the content")
+	}
+
+	score, article := parse(rules)
+	if score-defaultScore != 42 {
+		t.Errorf("custom rules, want the section scored 42 higher got %f", score-defaultScore)
+	}
+	if strings.Contains(article.TextContent, "stillinger") {
+		t.Errorf("custom rules, want the related links removed from the content")
+	}
+
+	// Only the tag score is left when the class weight is disabled
+	rules.DisableClassWeight = true
+	score, article = parse(rules)
+	if score-defaultScore != 2 {
+		t.Errorf("disabled class weight, want the section scored 2 higher got %f", score-defaultScore)
+	}
+	if !strings.Contains(article.TextContent, "stillinger") {
+		t.Errorf("disabled class weight, want the related links in the content")
+	}
+}
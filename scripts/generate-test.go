@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -110,7 +111,7 @@ func generateTestcase(testName, sourceURL string) error {
 	// Extract readable result.
 	parsedURL, _ := nurl.ParseRequestURI("http://fakehost/test/page.html")
 	article, err := readability.FromDocument(doc, parsedURL)
-	if err != nil {
+	if err != nil && !errors.Is(err, readability.ErrNoContent) {
 		return fmt.Errorf("failed to parse source: %v", err)
 	}
 
@@ -0,0 +1,325 @@
+package readability
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/andybalholm/cascadia"
+	"github.com/go-shiori/dom"
+	"golang.org/x/net/html"
+	"gopkg.in/yaml.v3"
+)
+
+// SiteRule is a set of CSS selectors for the pages of a site whose layout is
+// known, which take precedence over the heuristics of the parser. The
+// selectors that are empty or match nothing fall back to the heuristics.
+type SiteRule struct {
+	// Name identifies the rule in Article.SiteRule. Default: the first
+	// of Hosts.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+	// Hosts are the hostname patterns of the site. "example.com" matches
+	// example.com and www.example.com, while "*.example.com" matches all
+	// the subdomains of example.com. An exact pattern takes precedence
+	// over a wildcard, and a longer wildcard over a shorter one.
+	Hosts []string `json:"hosts" yaml:"hosts"`
+	// Content selects the article content. If it selects several nodes,
+	// all of them are used, in the order of the page.
+	Content string `json:"content,omitempty" yaml:"content,omitempty"`
+	// Remove selects the nodes that are removed from the page before the
+	// content is found, e.g. ads or related articles.
+	Remove []string `json:"remove,omitempty" yaml:"remove,omitempty"`
+	// Title selects the node whose text is the title.
+	Title string `json:"title,omitempty" yaml:"title,omitempty"`
+	// Byline selects the node whose text is the byline.
+	Byline string `json:"byline,omitempty" yaml:"byline,omitempty"`
+	// Date selects the node with the published time, which is its
+	// datetime or content attribute, or else its text.
+	Date string `json:"date,omitempty" yaml:"date,omitempty"`
+}
+
+// SiteRules is a registry of site rules by hostname pattern. It's immutable,
+// so it can be shared by parsers that are used concurrently.
+type SiteRules struct {
+	rules     []compiledSiteRule
+	hosts     map[string]int
+	wildcards map[string]int
+}
+
+// compiledSiteRule is a site rule with its selectors compiled.
+type compiledSiteRule struct {
+	SiteRule
+	content cascadia.Matcher
+	remove  []cascadia.Matcher
+	title   cascadia.Matcher
+	byline  cascadia.Matcher
+	date    cascadia.Matcher
+}
+
+// NewSiteRules checks the rules and indexes them by hostname pattern. It
+// fails if a selector is invalid, or if a pattern is used by more than one
+// rule.
+func NewSiteRules(rules []SiteRule) (*SiteRules, error) {
+	sr := &SiteRules{
+		hosts:     make(map[string]int),
+		wildcards: make(map[string]int),
+	}
+
+	for i, rule := range rules {
+		if len(rule.Hosts) == 0 {
+			return nil, fmt.Errorf("site rule %d: no hosts", i+1)
+		}
+		if rule.Name == "" {
+			rule.Name = rule.Hosts[0]
+		}
+
+		compiled := compiledSiteRule{SiteRule: rule}
+		compile := func(field, selector string) (cascadia.Matcher, error) {
+			if strings.TrimSpace(selector) == "" {
+				return nil, nil
+			}
+
+			group, err := cascadia.ParseGroup(selector)
+			if err != nil {
+				return nil, fmt.Errorf("site rule %q: invalid %s selector %q: %w", rule.Name, field, selector, err)
+			}
+			return group, nil
+		}
+
+		var err error
+		if compiled.content, err = compile("content", rule.Content); err != nil {
+			return nil, err
+		}
+		if compiled.title, err = compile("title", rule.Title); err != nil {
+			return nil, err
+		}
+		if compiled.byline, err = compile("byline", rule.Byline); err != nil {
+			return nil, err
+		}
+		if compiled.date, err = compile("date", rule.Date); err != nil {
+			return nil, err
+		}
+		for _, selector := range rule.Remove {
+			remove, err := compile("remove", selector)
+			if err != nil {
+				return nil, err
+			}
+			if remove != nil {
+				compiled.remove = append(compiled.remove, remove)
+			}
+		}
+
+		for _, pattern := range rule.Hosts {
+			index, key := sr.hosts, normalizeHost(pattern)
+			if domain, isWildcard := strings.CutPrefix(key, "*."); isWildcard {
+				index, key = sr.wildcards, domain
+			}
+
+			if key == "" || strings.Contains(key, "*") {
+				return nil, fmt.Errorf("site rule %q: invalid host pattern %q", rule.Name, pattern)
+			}
+			if other, exist := index[key]; exist {
+				return nil, fmt.Errorf("site rule %q: host pattern %q is already used by %q", rule.Name, pattern, sr.rules[other].Name)
+			}
+			index[key] = len(sr.rules)
+		}
+
+		sr.rules = append(sr.rules, compiled)
+	}
+
+	return sr, nil
+}
+
+// LoadSiteRules reads a list of site rules in JSON or YAML from r, and
+// indexes them with NewSiteRules. Unknown fields are rejected, so a typo
+// in a field name doesn't silently disable a selector. In YAML, a rule
+// looks like:
+//
+//	# site-rules.yaml
+//	- name: example
+//	  hosts: [example.com, "*.example.com"]
+//	  content: article .body
+//	  remove: [.ad, .related]
+//	  title: h1.headline
+//	  byline: .author
+//	  date: time[datetime]
+func LoadSiteRules(r io.Reader) (*SiteRules, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []SiteRule
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		decoder := json.NewDecoder(bytes.NewReader(trimmed))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&rules); err != nil {
+			return nil, fmt.Errorf("failed to decode site rules: %w", err)
+		}
+	} else if len(trimmed) > 0 {
+		decoder := yaml.NewDecoder(bytes.NewReader(trimmed))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&rules); err != nil {
+			return nil, fmt.Errorf("failed to decode site rules: %w", err)
+		}
+	}
+
+	return NewSiteRules(rules)
+}
+
+// Match returns the rule for host, if any.
+func (sr *SiteRules) Match(host string) (SiteRule, bool) {
+	if rule := sr.match(host); rule != nil {
+		return rule.SiteRule, true
+	}
+	return SiteRule{}, false
+}
+
+// match returns the compiled rule for host, or nil if there is none.
+func (sr *SiteRules) match(host string) *compiledSiteRule {
+	if sr == nil {
+		return nil
+	}
+
+	host = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+	if index, exist := sr.hosts[normalizeHost(host)]; exist {
+		return &sr.rules[index]
+	}
+
+	for domain := host; ; {
+		_, parent, found := strings.Cut(domain, ".")
+		if !found {
+			return nil
+		}
+
+		if index, exist := sr.wildcards[parent]; exist {
+			return &sr.rules[index]
+		}
+		domain = parent
+	}
+}
+
+// normalizeHost lowercases host, and strips the www subdomain and the
+// trailing dot, so the variants of a hostname are matched alike.
+func normalizeHost(host string) string {
+	host = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+	return strings.TrimPrefix(host, "www.")
+}
+
+// applySiteRule overrides the title, byline and published time in metadata
+// with the ones selected by rule, then removes the nodes selected by the
+// remove selectors of rule. It returns the authors named by the byline.
+func (ps *Parser) applySiteRule(rule *compiledSiteRule, metadata map[string]string) []Author {
+	var authors []Author
+	ps.logDebug("applying site rule", "rule", rule.Name)
+
+	if node := querySiteRule(ps.doc, rule.title); node != nil {
+		if title := trim(dom.TextContent(node)); title != "" {
+			ps.articleTitle = title
+		}
+	}
+
+	if node := querySiteRule(ps.doc, rule.byline); node != nil {
+		if byline := trim(dom.TextContent(node)); byline != "" {
+			metadata["byline"] = byline
+			authors = ps.getBylineAuthors(byline)
+		}
+	}
+
+	if node := querySiteRule(ps.doc, rule.date); node != nil {
+		date := dom.GetAttribute(node, "datetime")
+		if date == "" {
+			date = dom.GetAttribute(node, "content")
+		}
+		if date == "" {
+			date = dom.TextContent(node)
+		}
+		if date = strings.TrimSpace(date); date != "" {
+			metadata["publishedTime"] = date
+		}
+	}
+
+	for _, remove := range rule.remove {
+		ps.removeNodes(cascadia.QueryAll(ps.doc, remove), nil)
+	}
+
+	return authors
+}
+
+// getSiteRuleContent returns the article content selected by rule, wrapped
+// like the content found by grabArticle, or nil if the content selector
+// of rule selects nothing with text. The content isn't cleaned
+// conditionally, since the selector is trusted to select the content.
+func (ps *Parser) getSiteRuleContent(ctx context.Context, rule *compiledSiteRule) (*html.Node, error) {
+	if rule.content == nil {
+		return nil, nil
+	}
+
+	// The nodes that are nested in another selected node are already
+	// included with it.
+	var nodes []*html.Node
+	for _, node := range cascadia.QueryAll(ps.doc, rule.content) {
+		if len(nodes) == 0 || !isAncestorOf(nodes[len(nodes)-1], node) {
+			nodes = append(nodes, node)
+		}
+	}
+
+	page := dom.CreateElement("div")
+	dom.SetAttribute(page, "id", "readability-page-1")
+	dom.SetAttribute(page, "class", "page")
+	for _, node := range nodes {
+		dom.AppendChild(page, dom.Clone(node, true))
+	}
+
+	if ps.getInnerText(page, true) == "" {
+		ps.logDebug("site rule selected no content", "rule", rule.Name)
+		return nil, nil
+	}
+
+	articleContent := dom.CreateElement("div")
+	dom.AppendChild(articleContent, page)
+
+	// The flags and node states of the parse are restored afterwards, so
+	// they aren't changed for grabArticle.
+	flags, nodeStates := ps.flags, ps.nodeStates
+	ps.nodeStates = make(map[*html.Node]nodeState)
+	ps.flags.cleanConditionally = false
+	err := ps.prepArticle(ctx, articleContent)
+	ps.flags, ps.nodeStates = flags, nodeStates
+	if err != nil {
+		return nil, err
+	}
+
+	// Find out the language and text direction, like grabArticle does
+	ps.articleLang = dom.GetAttribute(dom.DocumentElement(ps.doc), "lang")
+	for node := nodes[0]; node != nil && node.Type == html.ElementNode; node = node.Parent {
+		if articleDir := strings.TrimSpace(dom.GetAttribute(node, "dir")); articleDir != "" {
+			ps.articleDir = articleDir
+			break
+		}
+	}
+
+	return articleContent, nil
+}
+
+// querySiteRule returns the first node in doc that is selected by selector,
+// or nil if selector is nil.
+func querySiteRule(doc *html.Node, selector cascadia.Matcher) *html.Node {
+	if selector == nil {
+		return nil
+	}
+	return cascadia.Query(doc, selector)
+}
+
+// isAncestorOf determines if ancestor is an ancestor of node.
+func isAncestorOf(ancestor, node *html.Node) bool {
+	for parent := node.Parent; parent != nil; parent = parent.Parent {
+		if parent == ancestor {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+package readability
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_LoadSiteRules(t *testing.T) {
+	yamlRules := `
+- name: example
+  hosts: [example.com, "*.example.com"]
+  content: article .body
+  remove: [.ad]
+- hosts: [news.example.com]
+  title: h1
+`
+	jsonRules := `[
+		{"name": "example", "hosts": ["example.com", "*.example.com"], "content": "article .body", "remove": [".ad"]},
+		{"hosts": ["news.example.com"], "title": "h1"}
+	]`
+
+	for name, input := range map[string]string{"yaml": yamlRules, "json": jsonRules} {
+		rules, err := LoadSiteRules(strings.NewReader(input))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		scenarios := []struct {
+			host     string
+			expected string
+		}{
+			{"example.com", "example"},
+			{"WWW.Example.com.", "example"},
+			{"blog.example.com", "example"},
+			{"a.b.example.com", "example"},
+			{"news.example.com", "news.example.com"},
+			{"example.org", ""},
+			{"notexample.com", ""},
+		}
+
+		for _, scenario := range scenarios {
+			rule, found := rules.Match(scenario.host)
+			if found != (scenario.expected != "") || rule.Name != scenario.expected {
+				t.Errorf("%s: %s, want rule %q got %q", name, scenario.host, scenario.expected, rule.Name)
+			}
+		}
+	}
+
+	invalids := map[string]string{
+		"unknown field":    "- hosts: [example.com]\n  contnet: article\n",
+		"invalid selector": `[{"hosts": ["example.com"], "content": "article[["}]`,
+		"duplicate host":   `[{"hosts": ["example.com"]}, {"hosts": ["www.example.com"]}]`,
+		"invalid host":     `[{"hosts": ["*.*.example.com"]}]`,
+		"no hosts":         `[{"content": "article"}]`,
+	}
+
+	for name, input := range invalids {
+		if _, err := LoadSiteRules(strings.NewReader(input)); err == nil {
+			t.Errorf("%s, want an error", name)
+		}
+	}
+}
+
+func Test_SiteRules_parse(t *testing.T) {
+	paragraph := "<p>This is a paragraph long enough to be considered the content of the article by the heuristics of the parser, if it gets the chance.</p>"
+	page := `<html lang="en"><head><title>Page title</title><meta name="author" content="John Roe"></head><body>
+		<h1 class="headline">The real headline</h1>
+		<span class="writer">By Jane Doe</span>
+		<time datetime="2024-05-01T10:00:00Z">May 1</time>
+		<div class="comments">` + strings.Repeat(paragraph, 10) + `</div>
+		<div class="story" dir="rtl">` + strings.Repeat(paragraph, 4) + `<div class="promo">Subscribe now</div></div>
+	</body></html>`
+
+	rules, err := NewSiteRules([]SiteRule{{
+		Name:    "fake",
+		Hosts:   []string{fakeHostURL.Hostname()},
+		Content: ".story",
+		Remove:  []string{".promo"},
+		Title:   ".headline",
+		Byline:  ".writer",
+		Date:    "time",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parser := NewParser()
+	parser.SiteRules = rules
+	article, err := parser.Parse(strings.NewReader(page), fakeHostURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if article.SiteRule != "fake" {
+		t.Errorf("want site rule %q got %q", "fake", article.SiteRule)
+	}
+	if want := "The real headline"; article.Title != want {
+		t.Errorf("want title %q got %q", want, article.Title)
+	}
+	if want := "By Jane Doe"; article.Byline != want {
+		t.Errorf("want byline %q got %q", want, article.Byline)
+	}
+	if want := []Author{{Name: "Jane Doe"}, {Name: "John Roe"}}; !reflect.DeepEqual(article.Authors, want) {
+		t.Errorf("want authors %v got %v", want, article.Authors)
+	}
+	if article.PublishedTime == nil || article.PublishedTime.Year() != 2024 {
+		t.Errorf("want the published time from the rule got %v", article.PublishedTime)
+	}
+	if article.Dir != "rtl" {
+		t.Errorf("want dir %q got %q", "rtl", article.Dir)
+	}
+	if strings.Count(article.TextContent, "paragraph long enough") != 4 {
+		t.Errorf("want only the selected content got %q", article.TextContent)
+	}
+	if strings.Contains(article.TextContent, "Subscribe") {
+		t.Errorf("want the removed nodes out of the content")
+	}
+
+	// The heuristics are used when the content selector selects nothing
+	rules, err = NewSiteRules([]SiteRule{{Hosts: []string{fakeHostURL.Hostname()}, Content: ".missing"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parser.SiteRules = rules
+	article, err = parser.Parse(strings.NewReader(page), fakeHostURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(article.TextContent, "paragraph long enough") {
+		t.Errorf("want the content found by the heuristics got %q", article.TextContent)
+	}
+	if want := fakeHostURL.Hostname(); article.SiteRule != want {
+		t.Errorf("want site rule %q got %q", want, article.SiteRule)
+	}
+
+	// Pages of other sites aren't affected
+	if _, found := rules.Match("example.org"); found {
+		t.Errorf("want no rule for another site")
+	}
+}
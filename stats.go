@@ -0,0 +1,161 @@
+package readability
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/go-shiori/dom"
+	"golang.org/x/net/html"
+)
+
+// ArticleStats are the statistics of the article content.
+type ArticleStats struct {
+	Words       int
+	Sentences   int
+	Paragraphs  int
+	Images      int
+	ReadingTime time.Duration
+}
+
+// readingSpeeds are the reading speeds of each language in words per
+// minute, as measured by the IReST study (Trauzettel-Klosinski et al.,
+// 2012). Chinese and Japanese are measured in characters per minute,
+// since each character is counted as a word by textWordCount.
+var readingSpeeds = map[string]float64{
+	"ar": 138,
+	"de": 179,
+	"en": 228,
+	"es": 218,
+	"fi": 161,
+	"fr": 195,
+	"he": 187,
+	"it": 188,
+	"ja": 357,
+	"nl": 202,
+	"pl": 166,
+	"pt": 181,
+	"ru": 184,
+	"sl": 180,
+	"sv": 199,
+	"tr": 166,
+	"zh": 255,
+}
+
+// defaultReadingSpeed is the reading speed used for the languages that
+// aren't in readingSpeeds, which is the mean of the IReST study.
+const defaultReadingSpeed = 184
+
+// sentenceEnds are the punctuation that ends a sentence. The full width
+// punctuation used by CJK languages isn't followed by a space.
+const (
+	sentenceEnds          = ".!?…"
+	fullWidthSentenceEnds = "。！？"
+)
+
+// getArticleStats counts the words, sentences, paragraphs and images in the
+// article content, and estimates the time it takes to read it in language.
+func getArticleStats(articleContent *html.Node, language string) ArticleStats {
+	var stats ArticleStats
+	if articleContent == nil {
+		return stats
+	}
+
+	for _, block := range getTextBlocks(articleContent) {
+		stats.Words += textWordCount(block)
+		stats.Sentences += sentenceCount(block)
+	}
+
+	for _, paragraph := range dom.GetElementsByTagName(articleContent, "p") {
+		if textWordCount(dom.TextContent(paragraph)) > 0 {
+			stats.Paragraphs++
+		}
+	}
+
+	stats.Images = len(dom.GetElementsByTagName(articleContent, "img"))
+
+	speed, exist := readingSpeeds[primaryLanguage(language)]
+	if !exist {
+		speed = defaultReadingSpeed
+	}
+
+	minutes := float64(stats.Words) / speed
+	stats.ReadingTime = time.Duration(minutes * float64(time.Minute)).Round(time.Second)
+	return stats
+}
+
+// getTextBlocks returns the text of each block in node, so the words and
+// sentences of adjacent blocks don't run together like they do in
+// TextContent. Table cells and line breaks also separate the blocks.
+func getTextBlocks(node *html.Node) []string {
+	var blocks []string
+	var sb strings.Builder
+
+	flush := func() {
+		if text := strings.TrimSpace(sb.String()); text != "" {
+			blocks = append(blocks, text)
+		}
+		sb.Reset()
+	}
+
+	var walk func(*html.Node)
+	walk = func(node *html.Node) {
+		switch node.Type {
+		case html.TextNode:
+			sb.WriteString(node.Data)
+			return
+		case html.ElementNode, html.DocumentNode:
+		default:
+			return
+		}
+
+		isBlock := isBlockElement(node)
+		switch dom.TagName(node) {
+		case "td", "th", "br":
+			isBlock = true
+		}
+
+		if isBlock {
+			flush()
+		}
+
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+
+		if isBlock {
+			flush()
+		}
+	}
+
+	walk(node)
+	flush()
+	return blocks
+}
+
+// sentenceCount returns the number of sentences in text. A sentence ends
+// with a full stop, question mark or exclamation mark followed by a space,
+// so decimal numbers and URLs aren't split. Any text after the last
+// punctuation, like a heading, is a sentence too.
+func sentenceCount(text string) int {
+	runes := []rune(text)
+	count := 0
+	inSentence := false
+
+	for i, r := range runes {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			inSentence = true
+		case !inSentence:
+		case strings.ContainsRune(fullWidthSentenceEnds, r),
+			strings.ContainsRune(sentenceEnds, r) && (i+1 == len(runes) || unicode.IsSpace(runes[i+1])):
+			count++
+			inSentence = false
+		}
+	}
+
+	if inSentence {
+		count++
+	}
+	return count
+}
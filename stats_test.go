@@ -0,0 +1,88 @@
+package readability
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+func Test_getArticleStats(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		content  string
+		language string
+		expected ArticleStats
+	}{{
+		name: "english",
+		content: `<h2>Heading</h2>` +
+			`<p>The first paragraph has two sentences. It costs $3.50, doesn't it?</p>` +
+			`<p><img src="a.png">Second<br>paragraph</p>` +
+			`<p> </p>` +
+			`<ul><li>One item</li><li>Another item.</li></ul>` +
+			`<table><tr><td>Cell</td><td>cell</td></tr></table>`,
+		language: "en-US",
+		expected: ArticleStats{
+			Words:       20,
+			Sentences:   9,
+			Paragraphs:  2,
+			Images:      1,
+			ReadingTime: 5 * time.Second,
+		},
+	}, {
+		name:     "chinese",
+		content:  `<p>我们正在寻找一位开发人员。希望在团队中工作！</p><p><img src="a.png"><img src="b.png"></p>`,
+		language: "zh",
+		expected: ArticleStats{
+			Words:       20,
+			Sentences:   2,
+			Paragraphs:  1,
+			Images:      2,
+			ReadingTime: 5 * time.Second,
+		},
+	}, {
+		name:     "japanese",
+		content:  `<p>私たちは、開発者を探しています。</p>`,
+		language: "ja",
+		expected: ArticleStats{
+			Words:       14,
+			Sentences:   1,
+			Paragraphs:  1,
+			ReadingTime: 2 * time.Second,
+		},
+	}}
+
+	for _, scenario := range scenarios {
+		doc, err := html.Parse(strings.NewReader("<div>" + scenario.content + "</div>"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		stats := getArticleStats(doc, scenario.language)
+		if stats != scenario.expected {
+			t.Errorf("%s,\nwant %+v\ngot  %+v", scenario.name, scenario.expected, stats)
+		}
+	}
+
+	if stats := getArticleStats(nil, "en"); stats != (ArticleStats{}) {
+		t.Errorf("nil content, want no stats got %+v", stats)
+	}
+}
+
+func Test_sentenceCount(t *testing.T) {
+	scenarios := map[string]int{
+		"":                                       0,
+		"A heading without punctuation":          1,
+		"One. Two! Three? Four…":                 4,
+		"Version 1.2 is out... Get it at go.dev": 2,
+		"他来了。她走了！你呢？":                            3,
+		"— . —":                                  0,
+	}
+
+	for text, expected := range scenarios {
+		if count := sentenceCount(text); count != expected {
+			t.Errorf("text %q, want %d got %d", text, expected, count)
+		}
+	}
+}
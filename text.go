@@ -0,0 +1,289 @@
+package readability
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-shiori/dom"
+	"golang.org/x/net/html"
+)
+
+// PlainText renders the article content as plain text that keeps the
+// structure of the article: blocks are separated by blank lines, list items
+// are bulleted and <br> breaks the line. If width is positive, lines are
+// wrapped at width characters, except for preformatted text and tables.
+//
+// Unlike TextContent, which is kept identical to Readability.js, the
+// output of PlainText may change between versions.
+func (article Article) PlainText(width int) string {
+	if article.Node == nil {
+		return ""
+	}
+	return RenderText(article.Node, width)
+}
+
+// RenderText renders node and its descendants as structured plain text.
+// See Article.PlainText for the details.
+func RenderText(node *html.Node, width int) string {
+	if isBlockElement(node) {
+		return txtBlock(node, width)
+	}
+	return txtParagraph(txtInline(node), width)
+}
+
+// txtBlocks renders the children of node as blocks separated by blank lines.
+func txtBlocks(node *html.Node, width int) string {
+	return strings.Join(txtBlockList(node, width), "\n\n")
+}
+
+// txtBlockList renders the children of node as a list of blocks.
+// Consecutive inline children are grouped into a single paragraph.
+func txtBlockList(node *html.Node, width int) []string {
+	var blocks []string
+	var inline strings.Builder
+
+	flushInline := func() {
+		if paragraph := txtParagraph(inline.String(), width); paragraph != "" {
+			blocks = append(blocks, paragraph)
+		}
+		inline.Reset()
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if !isBlockElement(child) {
+			inline.WriteString(txtInline(child))
+			continue
+		}
+
+		flushInline()
+		if block := txtBlock(child, width); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+
+	flushInline()
+	return blocks
+}
+
+// txtBlock renders a single block element.
+func txtBlock(node *html.Node, width int) string {
+	switch dom.TagName(node) {
+	case "h1", "h2", "h3", "h4", "h5", "h6", "p":
+		return txtParagraph(txtInlineChildren(node), width)
+
+	case "ul", "ol":
+		return txtList(node, width)
+
+	case "li":
+		return txtListItem("• ", node, width)
+
+	case "blockquote":
+		return prefixLines(txtBlocks(node, narrowWidth(width, 2)), "> ", ">")
+
+	case "pre":
+		text := strings.TrimRight(preformattedText(node), " \t\r\n")
+		if strings.TrimSpace(text) == "" {
+			return ""
+		}
+		return strings.TrimLeft(text, "\r\n")
+
+	case "hr":
+		return ""
+
+	case "table":
+		return txtTable(node)
+
+	default:
+		return txtBlocks(node, width)
+	}
+}
+
+// txtParagraph cleans up the inline text of a paragraph: whitespace is
+// collapsed, empty lines are removed and the remaining lines are wrapped.
+func txtParagraph(inline string, width int) string {
+	var lines []string
+	for _, line := range strings.Split(inline, "\n") {
+		line = strings.Trim(collapseSpaces(line), " ")
+		if line != "" {
+			lines = append(lines, wrapText(line, width)...)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// txtInlineChildren renders the children of node as inline text.
+func txtInlineChildren(node *html.Node) string {
+	var sb strings.Builder
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		sb.WriteString(txtInline(child))
+	}
+	return sb.String()
+}
+
+// txtInline renders node as inline text, where <br> is the only line break.
+// Block elements that are nested inside inline ones are flattened and
+// separated by spaces.
+func txtInline(node *html.Node) string {
+	switch node.Type {
+	case html.TextNode:
+		return collapseSpaces(node.Data)
+	case html.ElementNode:
+	default:
+		return ""
+	}
+
+	switch dom.TagName(node) {
+	case "script", "style", "noscript", "template", "img":
+		return ""
+	case "br":
+		return "\n"
+	}
+
+	if isBlockElement(node) {
+		return " " + txtInlineChildren(node) + " "
+	}
+
+	return txtInlineChildren(node)
+}
+
+// txtList renders <ul> and <ol> with a bullet or a number before each item.
+// Like in mdList, the items are separated by blank lines if any of them
+// contains a paragraph.
+func txtList(list *html.Node, width int) string {
+	ordered := dom.TagName(list) == "ol"
+	number := 1
+	if start, err := strconv.Atoi(dom.GetAttribute(list, "start")); ordered && err == nil {
+		number = start
+	}
+
+	var items []string
+	var marker string
+	loose := false
+	for child := dom.FirstElementChild(list); child != nil; child = dom.NextElementSibling(child) {
+		if tagName := dom.TagName(child); (tagName == "ul" || tagName == "ol") && len(items) > 0 {
+			markerWidth := utf8.RuneCountInString(marker)
+			if nested := txtList(child, narrowWidth(width, markerWidth)); nested != "" {
+				indent := strings.Repeat(" ", markerWidth)
+				items[len(items)-1] += "\n" + prefixLines(nested, indent, "")
+			}
+			continue
+		}
+
+		marker = "• "
+		if ordered {
+			marker = strconv.Itoa(number) + ". "
+			number++
+		}
+
+		if len(dom.GetElementsByTagName(child, "p")) > 0 {
+			loose = true
+		}
+
+		items = append(items, txtListItem(marker, child, width))
+	}
+
+	if loose {
+		return strings.Join(items, "\n\n")
+	}
+	return strings.Join(items, "\n")
+}
+
+// txtListItem renders a list item. The lines after the first one are
+// indented to line up with the text after the marker.
+func txtListItem(marker string, item *html.Node, width int) string {
+	markerWidth := utf8.RuneCountInString(marker)
+	blocks := txtBlockList(item, narrowWidth(width, markerWidth))
+	separator := "\n"
+	if len(dom.GetElementsByTagName(item, "p")) > 0 {
+		separator = "\n\n"
+	}
+
+	content := strings.Join(blocks, separator)
+	if content == "" {
+		return ""
+	}
+
+	indent := strings.Repeat(" ", markerWidth)
+	return marker + strings.TrimPrefix(prefixLines(content, indent, ""), indent)
+}
+
+// txtTable renders each row of <table> on its own line, with the cells
+// separated by tabs.
+func txtTable(table *html.Node) string {
+	var lines []string
+
+	var findRows func(*html.Node)
+	findRows = func(node *html.Node) {
+		for child := dom.FirstElementChild(node); child != nil; child = dom.NextElementSibling(child) {
+			switch dom.TagName(child) {
+			case "caption":
+				if caption := txtParagraph(txtInlineChildren(child), 0); caption != "" {
+					lines = append(lines, caption, "")
+				}
+
+			case "thead", "tbody", "tfoot":
+				findRows(child)
+
+			case "tr":
+				var cells []string
+				for cell := dom.FirstElementChild(child); cell != nil; cell = dom.NextElementSibling(cell) {
+					if tagName := dom.TagName(cell); tagName == "td" || tagName == "th" {
+						text := strings.ReplaceAll(txtInlineChildren(cell), "\n", " ")
+						cells = append(cells, strings.Trim(collapseSpaces(text), " "))
+					}
+				}
+
+				if row := strings.Join(cells, "\t"); strings.TrimSpace(row) != "" {
+					lines = append(lines, row)
+				}
+			}
+		}
+	}
+
+	findRows(table)
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// wrapText splits line into lines that are at most width characters long.
+// Words longer than width are not broken. It returns line as is if width
+// isn't positive.
+func wrapText(line string, width int) []string {
+	if width <= 0 || utf8.RuneCountInString(line) <= width {
+		return []string{line}
+	}
+
+	var lines []string
+	var current strings.Builder
+	currentWidth := 0
+	for _, word := range strings.Split(line, " ") {
+		wordWidth := utf8.RuneCountInString(word)
+		if currentWidth > 0 && currentWidth+1+wordWidth > width {
+			lines = append(lines, current.String())
+			current.Reset()
+			currentWidth = 0
+		}
+
+		if currentWidth > 0 {
+			current.WriteByte(' ')
+			currentWidth++
+		}
+		current.WriteString(word)
+		currentWidth += wordWidth
+	}
+
+	if currentWidth > 0 {
+		lines = append(lines, current.String())
+	}
+
+	return lines
+}
+
+// narrowWidth returns the width that is left after indenting by n
+// characters. It never goes below 1, so indented text is still wrapped.
+func narrowWidth(width, n int) int {
+	if width <= 0 {
+		return width
+	}
+	return max(width-n, 1)
+}
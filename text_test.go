@@ -0,0 +1,73 @@
+package readability
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-shiori/dom"
+)
+
+func Test_RenderText(t *testing.T) {
+	scenarios := []struct {
+		source   string
+		width    int
+		expected string
+	}{{
+		source: `<h1>Title</h1><p>First <em>paragraph</em>.</p><p>Second<br>paragraph.</p>`,
+		expected: "" +
+			"Title\n\n" +
+			"First paragraph.\n\n" +
+			"Second\n" +
+			"paragraph.",
+	}, {
+		source: `<ul><li>One</li><li>Two<ol><li>Nested</li></ol></li></ul><ol start="9"><li>Nine</li><li>Ten</li></ol>`,
+		expected: "" +
+			"• One\n" +
+			"• Two\n" +
+			"  1. Nested\n\n" +
+			"9. Nine\n" +
+			"10. Ten",
+	}, {
+		source: `<div>Loose <a href="#">text</a><img src="a.png" alt="image"><blockquote><p>Quoted</p></blockquote></div>`,
+		expected: "" +
+			"Loose text\n\n" +
+			"> Quoted",
+	}, {
+		source: "<pre>func main() {\n\tfmt.Println(\"Hello\")\n}\n</pre>",
+		expected: "" +
+			"func main() {\n" +
+			"\tfmt.Println(\"Hello\")\n" +
+			"}",
+	}, {
+		source: `<table><caption>Scores</caption><tr><th>Name</th><th>Score</th></tr><tr><td>Alice</td><td>10</td></tr></table>`,
+		expected: "" +
+			"Scores\n\n" +
+			"Name\tScore\n" +
+			"Alice\t10",
+	}, {
+		source: `<p>The quick brown fox jumps over the lazy dog</p><ul><li>The quick brown fox jumps</li></ul>`,
+		width:  16,
+		expected: "" +
+			"The quick brown\n" +
+			"fox jumps over\n" +
+			"the lazy dog\n\n" +
+			"• The quick\n" +
+			"  brown fox\n" +
+			"  jumps",
+	}}
+
+	for _, scenario := range scenarios {
+		doc, err := dom.Parse(strings.NewReader("<div>" + scenario.source + "</div>"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body := dom.GetElementsByTagName(doc, "body")[0]
+		if result := RenderText(dom.FirstElementChild(body), scenario.width); result != scenario.expected {
+			t.Errorf("\n"+
+				"source : %s\n"+
+				"want   : %q\n"+
+				"got    : %q", scenario.source, scenario.expected, result)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package readability
+
+import (
+	"log/slog"
+	"strings"
+
+	"github.com/go-shiori/dom"
+	"golang.org/x/net/html"
+)
+
+// RemovalReason is why a node was removed while grabbing the article.
+type RemovalReason string
+
+// The reasons for removing a node.
+const (
+	// RemovalHidden is a node that isn't visible to the user.
+	RemovalHidden RemovalReason = "hidden"
+	// RemovalDialog is a modal dialog.
+	RemovalDialog RemovalReason = "dialog"
+	// RemovalByline is the byline of the article, which is kept in
+	// Article.Byline instead.
+	RemovalByline RemovalReason = "byline"
+	// RemovalDuplicateHeader is a header that duplicates the title.
+	RemovalDuplicateHeader RemovalReason = "duplicate-header"
+	// RemovalUnlikelyCandidate is a node whose class or id looks like
+	// it isn't content, e.g. "sidebar" or "comment".
+	RemovalUnlikelyCandidate RemovalReason = "unlikely-candidate"
+	// RemovalRole is a node with a role that isn't content, e.g.
+	// "navigation".
+	RemovalRole RemovalReason = "role"
+	// RemovalEmpty is a container without any content.
+	RemovalEmpty RemovalReason = "empty"
+)
+
+// SiblingReason is why a sibling of the top candidate was merged into the
+// article content.
+type SiblingReason string
+
+// The reasons for merging a sibling.
+const (
+	// SiblingScore is a sibling whose score, including the bonus for
+	// sharing the class of the top candidate, is high enough.
+	SiblingScore SiblingReason = "score"
+	// SiblingParagraph is a paragraph that looks like content, because
+	// it's long with few links, or a short sentence without links.
+	SiblingParagraph SiblingReason = "paragraph"
+)
+
+// traceTextLength is the max number of chars of text in a TraceNode.
+const traceTextLength = 80
+
+// Trace records the decisions made while grabbing the article, so a bad
+// extraction can be debugged from the result alone. It's only recorded if
+// Parser.Trace is enabled.
+type Trace struct {
+	// Attempts are the attempts to grab the article, in order. Each one
+	// relaxes the flags of the previous one, until the content is long
+	// enough.
+	Attempts []TraceAttempt
+	// FinalAttempt is the index of the attempt that produced the article
+	// content, or -1 if none did.
+	FinalAttempt int
+}
+
+// TraceAttempt is an attempt to grab the article with a set of flags.
+type TraceAttempt struct {
+	StripUnlikelys     bool
+	UseWeightClasses   bool
+	CleanConditionally bool
+
+	Removed           []TraceRemoval
+	Candidates        []TraceCandidate
+	TopCandidate      *TraceNode
+	TopCandidateScore float64
+	Siblings          []TraceSibling
+	TextLength        int
+}
+
+// TraceNode identifies a node of the document.
+type TraceNode struct {
+	// Path is a CSS-like path from the root of the document to the node,
+	// e.g. "html > body > div#main.content > p".
+	Path string
+	// Text is the start of the text of the node.
+	Text string
+
+	// id is the debug id of the node, if Parser.DebugHTML is enabled.
+	id string
+}
+
+// TraceRemoval is a node that was removed, and why.
+type TraceRemoval struct {
+	Node   TraceNode
+	Reason RemovalReason
+	// Detail is the class and id that matched for unlikely candidates,
+	// the role, or the title for duplicate headers.
+	Detail string
+}
+
+// TraceCandidate is a node that was scored as a candidate for the article
+// content. The adjusted score is the raw score scaled down by the link
+// density.
+type TraceCandidate struct {
+	Node          TraceNode
+	Score         float64
+	LinkDensity   float64
+	AdjustedScore float64
+}
+
+// TraceSibling is a sibling of the top candidate that was merged into the
+// article content.
+type TraceSibling struct {
+	Node   TraceNode
+	Score  float64
+	Bonus  float64
+	Reason SiblingReason
+}
+
+// currentTraceAttempt returns the attempt that is being traced, or nil if
+// tracing is disabled.
+func (ps *Parser) currentTraceAttempt() *TraceAttempt {
+	if ps.trace == nil || len(ps.trace.Attempts) == 0 {
+		return nil
+	}
+	return &ps.trace.Attempts[len(ps.trace.Attempts)-1]
+}
+
+// logRemoval logs that node is about to be removed, and records it in the
+// trace. The match string is the class and id of the node.
+func (ps *Parser) logRemoval(node *html.Node, reason RemovalReason, matchString, detail string) {
+	ps.logDebug("removing node",
+		"attempt", ps.attempt,
+		"reason", reason,
+		"node", logNode{node},
+		"match", matchString,
+		"detail", detail)
+
+	if attempt := ps.currentTraceAttempt(); attempt != nil {
+		attempt.Removed = append(attempt.Removed, TraceRemoval{
+			Node:   newTraceNode(node),
+			Reason: reason,
+			Detail: detail,
+		})
+	}
+}
+
+// logNode is a node that is logged as a group of its path and the start
+// of its text. They are only computed if the record is actually logged.
+type logNode struct {
+	node *html.Node
+}
+
+// LogValue implements slog.LogValuer.
+func (n logNode) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("path", describeNodePath(n.node)),
+		slog.String("text", describeNodeText(n.node, traceTextLength)))
+}
+
+// newTraceNode describes node by its path and the start of its text.
+func newTraceNode(node *html.Node) TraceNode {
+	return TraceNode{
+		Path: describeNodePath(node),
+		Text: describeNodeText(node, traceTextLength),
+		id:   dom.GetAttribute(node, debugIDAttr),
+	}
+}
+
+// describeNodePath returns a CSS-like path to node, which is used instead of
+// its outer HTML in traces and logs.
+func describeNodePath(node *html.Node) string {
+	var parts []string
+	for ; node != nil && node.Type == html.ElementNode; node = node.Parent {
+		part := dom.TagName(node)
+		if id := strings.TrimSpace(dom.ID(node)); id != "" {
+			part += "#" + strings.Join(strings.Fields(id), "_")
+		}
+		for _, class := range strings.Fields(dom.ClassName(node)) {
+			part += "." + class
+		}
+		parts = append(parts, part)
+	}
+
+	for i, j := 0, len(parts)-1; i < j; i, j = i+1, j-1 {
+		parts[i], parts[j] = parts[j], parts[i]
+	}
+	return strings.Join(parts, " > ")
+}
+
+// describeNodeText returns the first maxChars chars of the text in node,
+// with normalized whitespace. It stops walking the node as soon as it has
+// enough text, so it's cheap for large nodes.
+func describeNodeText(node *html.Node, maxChars int) string {
+	var runes []rune
+	lastSpace := true
+
+	var walk func(*html.Node) bool
+	walk = func(node *html.Node) bool {
+		if node.Type == html.TextNode {
+			for _, r := range node.Data {
+				isSpace := strings.ContainsRune(" \t\n\r\f", r)
+				if isSpace && lastSpace {
+					continue
+				}
+				if isSpace {
+					r = ' '
+				}
+				runes = append(runes, r)
+				lastSpace = isSpace
+				if len(runes) >= maxChars {
+					return false
+				}
+			}
+			return true
+		}
+
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			if !walk(child) {
+				return false
+			}
+		}
+		return true
+	}
+
+	walk(node)
+	return strings.TrimSpace(string(runes))
+}
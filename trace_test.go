@@ -0,0 +1,129 @@
+package readability
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func Test_Trace(t *testing.T) {
+	paragraph := strings.Repeat("Lorem ipsum dolor sit amet, consectetur adipiscing elit. ", 4)
+	page := `<html><head><title>Trace test</title></head><body>
+		<div style="display:none">Hidden</div>
+		<nav role="navigation"><a href="/">Home</a></nav>
+		<div class="sidebar">Related links</div>
+		<div id="main">
+			<h1>Trace test</h1>
+			<p class="byline">By Jane Doe</p>
+			<div class="post"><p>` + paragraph + `</p><p>` + paragraph + `</p><p>` + paragraph + `</p></div>
+			<div class="post"><p>` + paragraph + `</p><p>` + paragraph + `</p><p>` + paragraph + `</p></div>
+		</div>
+	</body></html>`
+
+	parser := NewParser()
+	article, err := parser.Parse(strings.NewReader(page), fakeHostURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if article.Trace != nil {
+		t.Fatal("trace should only be recorded when enabled")
+	}
+
+	parser.Trace = true
+	article, err = parser.Parse(strings.NewReader(page), fakeHostURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	trace := article.Trace
+	if trace == nil || len(trace.Attempts) != 1 || trace.FinalAttempt != 0 {
+		t.Fatalf("want a single successful attempt, got %+v", trace)
+	}
+
+	attempt := trace.Attempts[0]
+	if !attempt.StripUnlikelys || !attempt.UseWeightClasses || !attempt.CleanConditionally {
+		t.Errorf("first attempt should have all flags, got %+v", attempt)
+	}
+
+	reasons := make(map[RemovalReason]TraceRemoval)
+	for _, removal := range attempt.Removed {
+		reasons[removal.Reason] = removal
+	}
+
+	expectedRemovals := map[RemovalReason]string{
+		RemovalHidden:            "html > body > div",
+		RemovalRole:              "html > body > nav",
+		RemovalUnlikelyCandidate: "html > body > div.sidebar",
+		RemovalDuplicateHeader:   "html > body > div#main > h1",
+		RemovalByline:            "html > body > div#main > p.byline",
+	}
+
+	for reason, path := range expectedRemovals {
+		if removal, exist := reasons[reason]; !exist || removal.Node.Path != path {
+			t.Errorf("removal %q, want %q got %+v", reason, path, removal)
+		}
+	}
+
+	if detail := reasons[RemovalRole].Detail; detail != "navigation" {
+		t.Errorf("role detail, want %q got %q", "navigation", detail)
+	}
+
+	if len(attempt.Candidates) == 0 {
+		t.Fatal("candidates should be recorded")
+	}
+	for _, candidate := range attempt.Candidates {
+		if candidate.AdjustedScore != candidate.Score*(1-candidate.LinkDensity) {
+			t.Errorf("candidate %q, adjusted score doesn't match", candidate.Node.Path)
+		}
+	}
+
+	if attempt.TopCandidate == nil || attempt.TopCandidate.Path != "html > body > div#main > div.post" {
+		t.Errorf("top candidate, got %+v", attempt.TopCandidate)
+	}
+
+	if len(attempt.Siblings) != 1 || attempt.Siblings[0].Reason != SiblingScore || attempt.Siblings[0].Bonus == 0 {
+		t.Errorf("the other post should be merged by score, got %+v", attempt.Siblings)
+	}
+
+	if attempt.TextLength != article.Length {
+		t.Errorf("text length, want %d got %d", article.Length, attempt.TextLength)
+	}
+}
+
+func Test_Trace_attempts(t *testing.T) {
+	page := `<html><body><div class="comment"><p>` + strings.Repeat("Lorem ipsum dolor sit amet. ", 4) + `</p></div></body></html>`
+
+	parser := NewParser()
+	parser.Trace = true
+	article, err := parser.Parse(strings.NewReader(page), fakeHostURL)
+	if !errors.Is(err, ErrNoContent) {
+		t.Fatalf("want ErrNoContent, got %v", err)
+	}
+
+	trace := article.Trace
+	if len(trace.Attempts) != 4 {
+		t.Fatalf("want 4 attempts, got %d", len(trace.Attempts))
+	}
+
+	// The comment is only kept once unlikely candidates aren't stripped
+	if trace.Attempts[0].TextLength != 0 || trace.Attempts[1].TextLength == 0 {
+		t.Errorf("unexpected text lengths, got %+v", trace.Attempts)
+	}
+
+	if trace.FinalAttempt != 1 {
+		t.Errorf("final attempt, want 1 got %d", trace.FinalAttempt)
+	}
+}
+
+func Test_describeNodeText(t *testing.T) {
+	doc, err := html.Parse(strings.NewReader("<p>  Hello,\n\t<b>brave</b>   new   world  </p>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if text := describeNodeText(doc, 11); text != "Hello, brav" {
+		t.Errorf("want %q got %q", "Hello, brav", text)
+	}
+}
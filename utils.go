@@ -3,6 +3,7 @@ package readability
 import (
 	nurl "net/url"
 	"strings"
+	"unicode"
 	"unicode/utf8"
 )
 
@@ -23,6 +24,44 @@ func wordCount(str string) int {
 	return len(strings.Fields(str))
 }
 
+// textWordCount returns number of word in str like wordCount, except that
+// CJK characters are counted as a word each, since those languages aren't
+// written with spaces between words. Combining marks, like the voicing
+// marks of kana, are part of the preceding character, and punctuation on
+// its own isn't a word.
+func textWordCount(str string) int {
+	nCJK := 0
+	prevCJK := false
+	str = strings.Map(func(r rune) rune {
+		switch {
+		case isCJK(r):
+			nCJK++
+			prevCJK = true
+			return ' '
+		case prevCJK && unicode.Is(unicode.Mn, r):
+			return -1
+		default:
+			prevCJK = false
+			return r
+		}
+	}, str)
+
+	nWords := 0
+	for _, word := range strings.Fields(str) {
+		if strings.IndexFunc(word, func(r rune) bool {
+			return unicode.IsLetter(r) || unicode.IsNumber(r)
+		}) >= 0 {
+			nWords++
+		}
+	}
+	return nCJK + nWords
+}
+
+// isCJK checks if r is a Chinese character or Japanese kana.
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
+
 // charCount returns number of char in str.
 func charCount(str string) int {
 	return utf8.RuneCountInString(str)
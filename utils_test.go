@@ -46,6 +46,27 @@ func Test_wordCount(t *testing.T) {
 	}
 }
 
+func Test_textWordCount(t *testing.T) {
+	scenarios := map[string]int{
+		"Karl Lagerfeld, best known for his work at Chanel, dies at 85.": 12,
+		"Hello — world":             2,
+		"我们正在寻找开发人员。":               10,
+		"私たちは、開発者を探しています。":          14,
+		"\u30ab\u3099 is two runes": 4,
+		"Go 语言 is fast":             5,
+		"서울에서 일하고 싶습니다":             3,
+	}
+
+	for sentence, expected := range scenarios {
+		if count := textWordCount(sentence); count != expected {
+			t.Errorf("\n"+
+				"sentence : \"%s\"\n"+
+				"want     : %d\n"+
+				"got      : %d", sentence, expected, count)
+		}
+	}
+}
+
 func Test_toAbsoluteURI(t *testing.T) {
 	baseURL, _ := nurl.ParseRequestURI("http://localhost:8080/absolute/")
 